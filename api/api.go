@@ -8,14 +8,24 @@ import (
 	"github.com/go-chi/chi"
 	"github.com/go-chi/chi/middleware"
 	"github.com/goware/cors"
+	"github.com/murdinc/awsm/aws"
 	"github.com/murdinc/terminal"
 	"github.com/skratchdot/open-golang/open"
 )
 
-// StartAPI Starts the API listener on port 8081
-func StartAPI(withDashboard bool) error {
+// StartAPI Starts the API listener on port 8081, optionally hosting the snapshot and image scheduler
+func StartAPI(withDashboard, withScheduler, dryRun bool) error {
 	r := chi.NewRouter()
 
+	if withScheduler {
+		go func() {
+			err := aws.StartScheduler(dryRun)
+			if err != nil {
+				terminal.ErrorLine("Error starting the scheduler: " + err.Error())
+			}
+		}()
+	}
+
 	cors := cors.New(cors.Options{
 		AllowedOrigins:   []string{"*"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE"},
@@ -48,6 +58,10 @@ func StartAPI(withDashboard bool) error {
 				r.Get("/", getAssets)
 			})
 		})
+		r.Get("/metrics", getMetrics)
+		r.Route("/scheduler", func(r chi.Router) {
+			r.Get("/status", getSchedulerStatus)
+		})
 		r.Route("/classes", func(r chi.Router) {
 			r.Get("/export", exportClasses)
 			//r.Get("/import", importClasses) // TODO
@@ -58,6 +58,9 @@ func getAssets(w http.ResponseWriter, r *http.Request) {
 	case "loadbalancers":
 		resp, errs = aws.GetLoadBalancers("")
 
+	case "networkacls":
+		resp, errs = aws.GetNetworkAcls("")
+
 	case "scalingpolicies":
 		resp, errs = aws.GetScalingPolicies("")
 
@@ -79,6 +82,15 @@ func getAssets(w http.ResponseWriter, r *http.Request) {
 	case "vpcs":
 		resp, errs = aws.GetVpcs("")
 
+	case "loggroups":
+		resp, errs = aws.GetLogGroups("")
+
+	case "certificates":
+		resp, errs = aws.GetCertificates("")
+
+	case "vpcendpoints":
+		resp, errs = aws.GetVpcEndpoints("")
+
 		/*
 			case "buckets": // TODO
 				resp, errs = aws.GetBuckets()
@@ -110,6 +110,9 @@ func putClass(w http.ResponseWriter, r *http.Request) {
 	case "subnets":
 		class, err = config.SaveSubnetClass(className, data)
 
+	case "networkacls":
+		class, err = config.SaveNetworkAclClass(className, data)
+
 	case "instances":
 		class, err = config.SaveInstanceClass(className, data)
 
@@ -143,6 +146,9 @@ func putClass(w http.ResponseWriter, r *http.Request) {
 	case "keypairs":
 		class, err = config.SaveKeyPairClass(className, data)
 
+	case "commands":
+		class, err = config.SaveCommandClass(className, data)
+
 	}
 
 	if err != nil {
@@ -0,0 +1,23 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/render"
+	"github.com/murdinc/awsm/aws"
+)
+
+func getMetrics(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	period, _ := strconv.Atoi(query.Get("period"))
+
+	resp, err := aws.GetMetrics(query.Get("search"), query.Get("type"), query.Get("metric"), query.Get("statistic"), period, query.Get("since"))
+	if err != nil {
+		render.JSON(w, r, map[string]interface{}{"metrics": resp, "success": false, "errors": []string{err.Error()}})
+		return
+	}
+
+	render.JSON(w, r, map[string]interface{}{"metrics": resp, "success": true})
+}
@@ -0,0 +1,18 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/murdinc/awsm/aws"
+)
+
+func getSchedulerStatus(w http.ResponseWriter, r *http.Request) {
+	resp, err := aws.GetSchedules()
+	if err != nil {
+		render.JSON(w, r, map[string]interface{}{"success": false, "errors": []string{err.Error()}})
+		return
+	}
+
+	render.JSON(w, r, map[string]interface{}{"schedulerRunning": aws.SchedulerStarted(), "schedules": resp, "success": true})
+}
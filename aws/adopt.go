@@ -0,0 +1,443 @@
+package aws
+
+import (
+	"errors"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/murdinc/awsm/config"
+	"github.com/murdinc/terminal"
+	"github.com/olekukonko/tablewriter"
+)
+
+// versionSuffix matches the -vN suffix awsm adds to the names of versioned assets
+var versionSuffix = regexp.MustCompile(`-v[0-9]+$`)
+
+// Adopt reverse-engineers a class from a single existing asset, saves it and tags the asset with the class so that class-driven
+// commands manage it from then on
+func Adopt(assetType, search, class string, dryRun bool) error {
+
+	// --dry-run flag
+	if dryRun {
+		terminal.Information("--dry-run flag is set, not making any actual changes!")
+	}
+
+	if class == "" {
+		return errors.New("A class name is required!")
+	}
+
+	switch assetType {
+	case "securitygroups":
+		return adoptSecurityGroup(search, class, dryRun)
+	case "loadbalancers":
+		return adoptLoadBalancer(search, class, dryRun)
+	case "instances":
+		return adoptInstance(search, class, dryRun)
+	case "volumes":
+		return adoptVolume(search, class, dryRun)
+	case "autoscalegroups":
+		return adoptAutoScaleGroup(search, class, dryRun)
+	case "vpcs":
+		return adoptVpc(search, class, dryRun)
+	}
+
+	return errors.New("Asset type [" + assetType + "] can not be adopted! Must be one of: securitygroups, loadbalancers, instances, volumes, autoscalegroups, vpcs")
+}
+
+func adoptSecurityGroup(search, class string, dryRun bool) error {
+	secGrpList, errs := GetSecurityGroups(search)
+	if len(errs) > 0 {
+		return errors.New("Error gathering Security Group list")
+	}
+	if len(*secGrpList) != 1 {
+		secGrpList.PrintTable()
+		return errors.New("Please limit your search to return only one Security Group.")
+	}
+	secGrp := (*secGrpList)[0]
+
+	cfg := config.SecurityGroupClass{
+		Description:         secGrp.Description,
+		SecurityGroupGrants: secGrp.SecurityGroupGrants,
+	}
+
+	_, err := config.LoadSecurityGroupClass(class, false)
+	exists := err == nil
+
+	return adoptClass("securitygroups", class, cfg, config.SecurityGroupClasses{class: cfg}, exists, "Security Group ["+secGrp.Name+"] in ["+secGrp.Region+"]", dryRun, func() error {
+		return setEc2ClassTag(secGrp.GroupID, class, secGrp.Region)
+	})
+}
+
+func adoptLoadBalancer(search, class string, dryRun bool) error {
+	lbList, errs := GetLoadBalancers(search)
+	if len(errs) > 0 {
+		return errors.New("Error gathering Load Balancer list")
+	}
+	if len(*lbList) != 1 {
+		lbList.PrintTable()
+		return errors.New("Please limit your search to return only one Load Balancer.")
+	}
+	lb := (*lbList)[0]
+
+	secGrpClasses, err := lookupSecurityGroupClasses(lb.Region, lb.SecurityGroupIDs)
+	if err != nil {
+		return err
+	}
+
+	vpcClass, err := lookupVpcClass(lb.Region, lb.VpcID)
+	if err != nil {
+		return err
+	}
+
+	subnetClasses, err := lookupSubnetClasses(lb.Region, lb.SubnetIDs)
+	if err != nil {
+		return err
+	}
+
+	cfg := config.LoadBalancerClass{
+		Scheme:                  lb.Scheme,
+		SecurityGroups:          secGrpClasses,
+		Vpc:                     vpcClass,
+		Subnets:                 subnetClasses,
+		AvailabilityZones:       lb.AvailabilityZones,
+		LoadBalancerListeners:   lb.LoadBalancerListeners,
+		LoadBalancerHealthCheck: lb.LoadBalancerHealthCheck,
+		LoadBalancerAttributes:  lb.LoadBalancerAttributes,
+	}
+
+	_, err = config.LoadLoadBalancerClass(class)
+	exists := err == nil
+
+	return adoptClass("loadbalancers", class, cfg, config.LoadBalancerClasses{class: cfg}, exists, "Load Balancer ["+lb.Name+"] in ["+lb.Region+"]", dryRun, func() error {
+		sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(lb.Region)}))
+		svc := elb.New(sess)
+
+		_, err := svc.AddTags(&elb.AddTagsInput{
+			LoadBalancerNames: []*string{aws.String(lb.Name)},
+			Tags: []*elb.Tag{
+				{
+					Key:   aws.String("Class"),
+					Value: aws.String(class),
+				},
+			},
+		})
+		return err
+	})
+}
+
+func adoptInstance(search, class string, dryRun bool) error {
+	instances, errs := GetInstances(search, false)
+	if len(errs) > 0 {
+		return errors.New("Error gathering Instance list")
+	}
+
+	// Terminated instances are gone for good
+	instList := new(Instances)
+	for _, instance := range *instances {
+		if instance.State != "terminated" && instance.State != "shutting-down" {
+			*instList = append(*instList, instance)
+		}
+	}
+
+	if len(*instList) != 1 {
+		instList.PrintTable()
+		return errors.New("Please limit your search to return only one Instance.")
+	}
+	instance := (*instList)[0]
+
+	secGrpClasses, err := lookupSecurityGroupClasses(instance.Region, instance.SecurityGroupIDs)
+	if err != nil {
+		return err
+	}
+
+	vpcClass, err := lookupVpcClass(instance.Region, instance.VPCID)
+	if err != nil {
+		return err
+	}
+
+	var subnetClass string
+	if instance.SubnetID != "" {
+		classes, err := lookupSubnetClasses(instance.Region, []string{instance.SubnetID})
+		if err != nil {
+			return err
+		}
+		subnetClass = classes[0]
+	}
+
+	// Instances launch from the latest Image of an Image class
+	imgList := new(Images)
+	GetRegionImages(instance.Region, imgList, instance.AMIID, false)
+	var amiClass string
+	for _, img := range *imgList {
+		if img.ImageID == instance.AMIID {
+			amiClass = img.Class
+		}
+	}
+	if amiClass == "" {
+		terminal.ShowErrorMessage("Warning", "Image ["+instance.AMIID+"] does not have a Class, the AMI of the Instance class will need to be set by hand!")
+	}
+
+	cfg := config.InstanceClass{
+		InstanceType:       instance.Size,
+		SecurityGroups:     secGrpClasses,
+		Vpc:                vpcClass,
+		Subnet:             subnetClass,
+		PublicIPAddress:    instance.PublicIP != "",
+		AMI:                amiClass,
+		KeyName:            versionSuffix.ReplaceAllString(instance.KeyPair, ""),
+		EbsOptimized:       instance.EbsOptimized,
+		Monitoring:         instance.Monitoring,
+		ShutdownBehavior:   instance.ShutdownBehavior,
+		IAMInstanceProfile: instance.IamInstanceProfileName,
+	}
+
+	if instance.SpotRequestID != "" {
+		cfg.MarketType = "spot"
+	}
+
+	_, err = config.LoadInstanceClass(class)
+	exists := err == nil
+
+	return adoptClass("instances", class, cfg, config.InstanceClasses{class: cfg}, exists, "Instance ["+instance.Name+"] ("+instance.InstanceID+") in ["+instance.Region+"]", dryRun, func() error {
+		return setEc2ClassTag(instance.InstanceID, class, instance.Region)
+	})
+}
+
+func adoptVolume(search, class string, dryRun bool) error {
+	volList, errs := GetVolumes(search, false)
+	if len(errs) > 0 {
+		return errors.New("Error gathering Volume list")
+	}
+	if len(*volList) != 1 {
+		volList.PrintTable()
+		return errors.New("Please limit your search to return only one Volume.")
+	}
+	volume := (*volList)[0]
+
+	iops, _ := strconv.Atoi(volume.Iops)
+
+	cfg := config.VolumeClass{
+		DeviceName:          volume.Device,
+		VolumeSize:          volume.Size,
+		DeleteOnTermination: volume.DeleteOnTerm,
+		VolumeType:          volume.VolumeType,
+		Iops:                iops,
+		Encrypted:           volume.Encrypted,
+	}
+
+	_, err := config.LoadVolumeClass(class)
+	exists := err == nil
+
+	return adoptClass("volumes", class, cfg, config.VolumeClasses{class: cfg}, exists, "Volume ["+volume.Name+"] ("+volume.VolumeID+") in ["+volume.Region+"]", dryRun, func() error {
+		return setEc2ClassTag(volume.VolumeID, class, volume.Region)
+	})
+}
+
+func adoptAutoScaleGroup(search, class string, dryRun bool) error {
+	asgList, errs := GetAutoScaleGroups(search)
+	if len(errs) > 0 {
+		return errors.New("Error gathering AutoScaling Group list")
+	}
+	if len(*asgList) != 1 {
+		asgList.PrintTable()
+		return errors.New("Please limit your search to return only one AutoScaling Group.")
+	}
+	asg := (*asgList)[0]
+
+	var subnetClass string
+	if asg.SubnetID != "" {
+		classes, err := lookupSubnetClasses(asg.Region, strings.Split(asg.SubnetID, ","))
+		if err != nil {
+			return err
+		}
+		subnetClass = classes[0]
+		for _, c := range classes[1:] {
+			if c != subnetClass {
+				return errors.New("AutoScaling Group [" + asg.Name + "] spans Subnets of more than one class, which an AutoScaling Group class can not describe!")
+			}
+		}
+	}
+
+	cfg := config.AutoscaleGroupClass{
+		LaunchConfigurationClass: versionSuffix.ReplaceAllString(asg.LaunchConfig, ""),
+		AvailabilityZones:        asg.AvailabilityZones,
+		DesiredCapacity:          asg.DesiredCapacity,
+		MinSize:                  asg.MinSize,
+		MaxSize:                  asg.MaxSize,
+		DefaultCooldown:          asg.DefaultCooldown,
+		SubnetClass:              subnetClass,
+		HealthCheckType:          asg.HealthCheckType,
+		HealthCheckGracePeriod:   asg.HealthCheckGracePeriod,
+		LoadBalancerNames:        asg.LoadBalancers,
+	}
+
+	_, err := config.LoadAutoscalingGroupClass(class)
+	exists := err == nil
+
+	return adoptClass("autoscalegroups", class, cfg, config.AutoscaleGroupClasses{class: cfg}, exists, "AutoScaling Group ["+asg.Name+"] in ["+asg.Region+"]", dryRun, func() error {
+		sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(asg.Region)}))
+		svc := autoscaling.New(sess)
+
+		_, err := svc.CreateOrUpdateTags(&autoscaling.CreateOrUpdateTagsInput{
+			Tags: []*autoscaling.Tag{
+				{
+					Key:               aws.String("Class"),
+					PropagateAtLaunch: aws.Bool(true),
+					ResourceId:        aws.String(asg.Name),
+					ResourceType:      aws.String("auto-scaling-group"),
+					Value:             aws.String(class),
+				},
+			},
+		})
+		return err
+	})
+}
+
+func adoptVpc(search, class string, dryRun bool) error {
+	vpcList, errs := GetVpcs(search)
+	if len(errs) > 0 {
+		return errors.New("Error gathering VPC list")
+	}
+	if len(*vpcList) != 1 {
+		vpcList.PrintTable()
+		return errors.New("Please limit your search to return only one VPC.")
+	}
+	vpc := (*vpcList)[0]
+
+	// Vpc classes only keep the netmask, the network is picked when the VPC is created
+	cfg := config.VpcClass{
+		Tenancy: vpc.Tenancy,
+	}
+	if i := strings.Index(vpc.CIDRBlock, "/"); i >= 0 {
+		cfg.CIDR = vpc.CIDRBlock[i:]
+	}
+
+	_, err := config.LoadVpcClass(class)
+	exists := err == nil
+
+	return adoptClass("vpcs", class, cfg, config.VpcClasses{class: cfg}, exists, "VPC ["+vpc.Name+"] ("+vpc.VpcID+") in ["+vpc.Region+"]", dryRun, func() error {
+		return setEc2ClassTag(vpc.VpcID, class, vpc.Region)
+	})
+}
+
+// adoptClass shows the reverse-engineered class, confirms, saves it and tags the asset
+func adoptClass(classType, class string, cfg, classes interface{}, exists bool, asset string, dryRun bool, tag func() error) error {
+
+	keys, values := config.ExtractAwsmClass(cfg)
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Class [" + class + "]", ""})
+	for i := range keys {
+		table.Append([]string{keys[i], values[i]})
+	}
+	table.Render()
+
+	if exists {
+		terminal.ShowErrorMessage("Warning", "The ["+class+"] "+classType+" class already exists and will be overwritten!")
+	}
+
+	// Confirm
+	if !terminal.PromptBool("Are you sure you want to adopt " + asset + " into the [" + class + "] class?") {
+		return errors.New("Aborting!")
+	}
+
+	if dryRun {
+		return nil
+	}
+
+	err := config.Insert(classType, classes)
+	if err != nil {
+		return err
+	}
+	terminal.Delta("Saved the [" + class + "] " + classType + " class!")
+
+	err = tag()
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return errors.New(awsErr.Message())
+		}
+		return err
+	}
+	terminal.Delta("Tagged " + asset + " with Class [" + class + "]!")
+
+	terminal.Information("Done!")
+
+	return nil
+}
+
+// lookupSecurityGroupClasses returns the classes of Security Groups by id, they need to be adopted before the assets that use them
+func lookupSecurityGroupClasses(region string, ids []string) ([]string, error) {
+	secGrpList := new(SecurityGroups)
+	err := GetRegionSecurityGroups(region, secGrpList, "")
+	if err != nil {
+		return nil, err
+	}
+
+	classes := make([]string, 0, len(ids))
+	for _, id := range ids {
+		for _, secGrp := range *secGrpList {
+			if secGrp.GroupID != id {
+				continue
+			}
+			if secGrp.Class == "" {
+				return nil, errors.New("Security Group [" + secGrp.Name + "] does not have a Class, please adopt it first!")
+			}
+			classes = append(classes, secGrp.Class)
+		}
+	}
+
+	return classes, nil
+}
+
+// lookupVpcClass returns the class of a VPC by id, it needs to be adopted before the assets inside of it
+func lookupVpcClass(region, id string) (string, error) {
+	if id == "" {
+		return "", nil
+	}
+
+	vpcList := new(Vpcs)
+	err := GetRegionVpcs(region, vpcList, id)
+	if err != nil {
+		return "", err
+	}
+
+	for _, vpc := range *vpcList {
+		if vpc.VpcID == id {
+			if vpc.Class == "" {
+				return "", errors.New("VPC [" + id + "] does not have a Class, please adopt it first!")
+			}
+			return vpc.Class, nil
+		}
+	}
+
+	return "", errors.New("VPC [" + id + "] was not found in [" + region + "]!")
+}
+
+// lookupSubnetClasses returns the classes of Subnets by id
+func lookupSubnetClasses(region string, ids []string) ([]string, error) {
+	subList := new(Subnets)
+	err := GetRegionSubnets(region, subList, "")
+	if err != nil {
+		return nil, err
+	}
+
+	classes := make([]string, len(ids))
+	for i, id := range ids {
+		for _, sub := range *subList {
+			if sub.SubnetID == id {
+				classes[i] = sub.Class
+			}
+		}
+		if classes[i] == "" {
+			return nil, errors.New("Subnet [" + id + "] was not found or does not have a Class, please tag it with one first!")
+		}
+	}
+
+	return classes, nil
+}
@@ -132,6 +132,10 @@ func (a *Alarm) Marshal(alarm *cloudwatch.MetricAlarm, region string) {
 	a.Description = aws.StringValue(alarm.AlarmDescription)
 	a.State = aws.StringValue(alarm.StateValue)
 	a.Trigger = fmt.Sprintf("%s %s %d (%s)", aws.StringValue(alarm.MetricName), operator, int(aws.Float64Value(alarm.Threshold)), aws.StringValue(alarm.Statistic))
+	a.MetricName = aws.StringValue(alarm.MetricName)
+	a.Statistic = aws.StringValue(alarm.Statistic)
+	a.Operator = aws.StringValue(alarm.ComparisonOperator)
+	a.Threshold = aws.Float64Value(alarm.Threshold)
 	a.Period = fmt.Sprint(aws.Int64Value(alarm.Period))
 	a.EvalPeriods = fmt.Sprint(aws.Int64Value(alarm.EvaluationPeriods))
 	a.ActionArns = actionArns
@@ -61,6 +61,12 @@ func GetAutoScaleGroups(search string) (*AutoScaleGroups, []error) {
 	return asgList, errs
 }
 
+// isClassAutoScaleGroup returns true if an AutoScale Group is the group of a class, either named after the class or after the class
+// and the Launch Configuration version it was deployed with
+func isClassAutoScaleGroup(name, class string) bool {
+	return name == class || versionSuffix.ReplaceAllString(name, "") == class
+}
+
 // GetRegionAutoScaleGroups returns a list of AutoScale Groups for a given region into the provided AutoScaleGroups slice
 func GetRegionAutoScaleGroups(region string, asgList *AutoScaleGroups, search string) error {
 
@@ -185,6 +191,58 @@ func (a *AutoScaleGroup) Marshal(autoscalegroup *autoscaling.Group, region strin
 	a.VpcID = subList.GetVpcIDBySubnetID(a.SubnetID)
 	a.VpcName = subList.GetVpcNameBySubnetID(a.SubnetID)
 	a.Region = region
+
+	if policy := autoscalegroup.MixedInstancesPolicy; policy != nil {
+		var instanceTypes []string
+		if policy.LaunchTemplate != nil {
+			if policy.LaunchTemplate.LaunchTemplateSpecification != nil {
+				a.LaunchConfig = aws.StringValue(policy.LaunchTemplate.LaunchTemplateSpecification.LaunchTemplateName)
+			}
+			for _, override := range policy.LaunchTemplate.Overrides {
+				instanceTypes = append(instanceTypes, aws.StringValue(override.InstanceType))
+			}
+		}
+
+		a.InstanceMix = strings.Join(instanceTypes, ", ")
+
+		if distribution := policy.InstancesDistribution; distribution != nil {
+			a.InstanceMix += fmt.Sprintf(" (on-demand base: %d, above base: %d%% on-demand, spot: %s)",
+				aws.Int64Value(distribution.OnDemandBaseCapacity),
+				aws.Int64Value(distribution.OnDemandPercentageAboveBaseCapacity),
+				aws.StringValue(distribution.SpotAllocationStrategy))
+		}
+	}
+}
+
+// mixedInstancesPolicy returns the Mixed Instances Policy of an AutoScale Group class for the given Launch Template
+func mixedInstancesPolicy(cfg config.AutoscaleGroupClass, launchTemplateName string) *autoscaling.MixedInstancesPolicy {
+
+	distribution := &autoscaling.InstancesDistribution{
+		OnDemandBaseCapacity:                aws.Int64(int64(cfg.OnDemandBaseCapacity)),
+		OnDemandPercentageAboveBaseCapacity: aws.Int64(int64(cfg.OnDemandPercentage())),
+	}
+
+	if cfg.SpotAllocationStrategy != "" {
+		distribution.SetSpotAllocationStrategy(cfg.SpotAllocationStrategy)
+	}
+
+	overrides := make([]*autoscaling.LaunchTemplateOverrides, len(cfg.InstanceTypeOverrides))
+	for i, instanceType := range cfg.InstanceTypeOverrides {
+		overrides[i] = &autoscaling.LaunchTemplateOverrides{
+			InstanceType: aws.String(instanceType),
+		}
+	}
+
+	return &autoscaling.MixedInstancesPolicy{
+		InstancesDistribution: distribution,
+		LaunchTemplate: &autoscaling.LaunchTemplate{
+			LaunchTemplateSpecification: &autoscaling.LaunchTemplateSpecification{
+				LaunchTemplateName: aws.String(launchTemplateName),
+				Version:            aws.String("$Latest"),
+			},
+			Overrides: overrides,
+		},
+	}
 }
 
 // Marshal parses the response from the aws sdk into an awsm AutoScale Group
@@ -249,131 +307,153 @@ func CreateAutoScaleGroups(class string, dryRun bool) (err error) {
 			Region: region,
 		})
 
-		// Verify that the latest Launch Configuration is available in this region
-		lcName := GetLaunchConfigurationName(region, cfg.LaunchConfigurationClass, launchConfigurationCfg.Version)
-		if lcName == "" {
-			return fmt.Errorf("Launch Configuration [%s] version [%d] is not available in [%s]!", cfg.LaunchConfigurationClass, launchConfigurationCfg.Version, region)
+		err := createAutoScaleGroup(class, cfg, launchConfigurationCfg, region, regionAZs, azs, dryRun)
+		if err != nil {
+			return err
 		}
-		terminal.Information(fmt.Sprintf("Found latest Launch Configuration [%s] version [%d] in [%s]", cfg.LaunchConfigurationClass, launchConfigurationCfg.Version, region))
+	}
 
-		sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
-		svc := autoscaling.New(sess)
+	// Create the Alarms and Scaling Policies
+	if len(cfg.Alarms) > 0 {
 
-		params := &autoscaling.CreateAutoScalingGroupInput{
-			AutoScalingGroupName:    aws.String(class),
-			MaxSize:                 aws.Int64(int64(cfg.MaxSize)),
-			MinSize:                 aws.Int64(int64(cfg.MinSize)),
-			DefaultCooldown:         aws.Int64(int64(cfg.DefaultCooldown)),
-			DesiredCapacity:         aws.Int64(int64(cfg.DesiredCapacity)),
-			HealthCheckGracePeriod:  aws.Int64(int64(cfg.HealthCheckGracePeriod)),
-			HealthCheckType:         aws.String(cfg.HealthCheckType),
-			LaunchConfigurationName: aws.String(lcName),
-
-			// TODO ?
-			// InstanceId:                       aws.String("XmlStringMaxLen19"),
-			// NewInstancesProtectedFromScaleIn: aws.Bool(true),
-			// PlacementGroup:                   aws.String("XmlStringMaxLen255"),
-			Tags: []*autoscaling.Tag{
-				{
-					// Name
-					Key:               aws.String("Name"),
-					PropagateAtLaunch: aws.Bool(true),
-					ResourceId:        aws.String(class),
-					ResourceType:      aws.String("auto-scaling-group"),
-					Value:             aws.String(lcName),
-				},
-				{
-					// Class
-					Key:               aws.String("Class"),
-					PropagateAtLaunch: aws.Bool(true),
-					ResourceId:        aws.String(class),
-					ResourceType:      aws.String("auto-scaling-group"),
-					Value:             aws.String(cfg.LaunchConfigurationClass),
-				},
-			},
-		}
+		terminal.Delta("Creating CloudWatch Alarms.")
 
-		subList := new(Subnets)
-		var vpcZones []string
+		for _, alarm := range cfg.Alarms {
 
-		if cfg.SubnetClass != "" {
-			err := GetRegionSubnets(region, subList, "")
+			alarmCfg, err := config.LoadAlarmClass(alarm)
 			if err != nil {
 				return err
 			}
-		}
+			terminal.Information("Found CloudWatch Alarm class configuration for [" + alarm + "]")
 
-		// Set the AZs
-		for _, az := range regionAZs {
-			if !azs.ValidAZ(az) {
-				return cli.NewExitError("Availability Zone ["+az+"] is Invalid!", 1)
+			err = createAutoScaleAlarms(alarm, alarmCfg, asgList, dryRun)
+			if err != nil {
+				return err
 			}
-			terminal.Information("Found Availability Zone [" + az + "]!")
+		}
+	}
 
-			params.AvailabilityZones = append(params.AvailabilityZones, aws.String(az))
+	return nil
 
-			for _, sub := range *subList {
-				if sub.Class == cfg.SubnetClass && sub.AvailabilityZone == az {
-					vpcZones = append(vpcZones, sub.SubnetID)
-				}
-			}
+}
 
-		}
+// private function without terminal prompts, creates a single AutoScale Group with the given name from a class in one region
+func createAutoScaleGroup(name string, cfg config.AutoscaleGroupClass, launchConfigurationCfg config.LaunchConfigurationClass, region string, regionAZs []string, azs *regions.AZs, dryRun bool) error {
 
-		// Set the VPCZoneIdentifier (SubnetIds seperated by comma)
-		params.VPCZoneIdentifier = aws.String(strings.Join(vpcZones, ", "))
+	// Verify that the latest Launch Configuration is available in this region
+	lcName := GetLaunchConfigurationName(region, cfg.LaunchConfigurationClass, launchConfigurationCfg.Version)
+	if lcName == "" {
+		return fmt.Errorf("Launch Configuration [%s] version [%d] is not available in [%s]!", cfg.LaunchConfigurationClass, launchConfigurationCfg.Version, region)
+	}
+	terminal.Information(fmt.Sprintf("Found latest Launch Configuration [%s] version [%d] in [%s]", cfg.LaunchConfigurationClass, launchConfigurationCfg.Version, region))
 
-		// Set the Load Balancers
-		for _, elb := range cfg.LoadBalancerNames {
-			params.LoadBalancerNames = append(params.LoadBalancerNames, aws.String(elb))
-		}
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := autoscaling.New(sess)
+
+	params := &autoscaling.CreateAutoScalingGroupInput{
+		AutoScalingGroupName:    aws.String(name),
+		MaxSize:                 aws.Int64(int64(cfg.MaxSize)),
+		MinSize:                 aws.Int64(int64(cfg.MinSize)),
+		DefaultCooldown:         aws.Int64(int64(cfg.DefaultCooldown)),
+		DesiredCapacity:         aws.Int64(int64(cfg.DesiredCapacity)),
+		HealthCheckGracePeriod:  aws.Int64(int64(cfg.HealthCheckGracePeriod)),
+		HealthCheckType:         aws.String(cfg.HealthCheckType),
+		LaunchConfigurationName: aws.String(lcName),
+
+		// TODO ?
+		// InstanceId:                       aws.String("XmlStringMaxLen19"),
+		// NewInstancesProtectedFromScaleIn: aws.Bool(true),
+		// PlacementGroup:                   aws.String("XmlStringMaxLen255"),
+		Tags: []*autoscaling.Tag{
+			{
+				// Name
+				Key:               aws.String("Name"),
+				PropagateAtLaunch: aws.Bool(true),
+				ResourceId:        aws.String(name),
+				ResourceType:      aws.String("auto-scaling-group"),
+				Value:             aws.String(lcName),
+			},
+			{
+				// Class
+				Key:               aws.String("Class"),
+				PropagateAtLaunch: aws.Bool(true),
+				ResourceId:        aws.String(name),
+				ResourceType:      aws.String("auto-scaling-group"),
+				Value:             aws.String(cfg.LaunchConfigurationClass),
+			},
+		},
+	}
 
-		// Set the Termination Policies
-		for _, terminationPolicy := range cfg.TerminationPolicies {
-			params.TerminationPolicies = append(params.TerminationPolicies, aws.String(terminationPolicy))
+	subList := new(Subnets)
+	var vpcZones []string
+
+	if cfg.SubnetClass != "" {
+		err := GetRegionSubnets(region, subList, "")
+		if err != nil {
+			return err
 		}
+	}
 
-		// Create it!
-		if !dryRun {
-			_, err := svc.CreateAutoScalingGroup(params)
-			if err != nil {
-				if awsErr, ok := err.(awserr.Error); ok {
-					return errors.New(awsErr.Message())
-				}
-				return err
-			}
+	// Set the AZs
+	for _, az := range regionAZs {
+		if !azs.ValidAZ(az) {
+			return cli.NewExitError("Availability Zone ["+az+"] is Invalid!", 1)
+		}
+		terminal.Information("Found Availability Zone [" + az + "]!")
 
-			terminal.Delta("Created AutoScaling Group [" + aws.StringValue(params.AutoScalingGroupName) + "] in [" + region + "]!")
+		params.AvailabilityZones = append(params.AvailabilityZones, aws.String(az))
 
-			terminal.Information("Done!")
-		} else {
-			terminal.Notice("Params:")
-			fmt.Println(params.String())
+		for _, sub := range *subList {
+			if sub.Class == cfg.SubnetClass && sub.AvailabilityZone == az {
+				vpcZones = append(vpcZones, sub.SubnetID)
+			}
 		}
+
 	}
 
-	// Create the Alarms and Scaling Policies
-	if len(cfg.Alarms) > 0 {
+	// Set the VPCZoneIdentifier (SubnetIds seperated by comma)
+	params.VPCZoneIdentifier = aws.String(strings.Join(vpcZones, ", "))
 
-		terminal.Delta("Creating CloudWatch Alarms.")
+	// Set the Load Balancers
+	for _, elb := range cfg.LoadBalancerNames {
+		params.LoadBalancerNames = append(params.LoadBalancerNames, aws.String(elb))
+	}
 
-		for _, alarm := range cfg.Alarms {
+	// Set the Termination Policies
+	for _, terminationPolicy := range cfg.TerminationPolicies {
+		params.TerminationPolicies = append(params.TerminationPolicies, aws.String(terminationPolicy))
+	}
 
-			alarmCfg, err := config.LoadAlarmClass(alarm)
-			if err != nil {
-				return err
-			}
-			terminal.Information("Found CloudWatch Alarm class configuration for [" + alarm + "]")
+	// Set the Mixed Instances Policy
+	if len(cfg.InstanceTypeOverrides) > 0 {
+		launchTemplateName, err := createLaunchTemplateFromLaunchConfiguration(lcName, region, dryRun)
+		if err != nil {
+			return err
+		}
 
-			err = createAutoScaleAlarms(alarm, alarmCfg, asgList, dryRun)
-			if err != nil {
-				return err
+		params.LaunchConfigurationName = nil
+		params.MixedInstancesPolicy = mixedInstancesPolicy(cfg, launchTemplateName)
+	}
+
+	// Create it!
+	if !dryRun {
+		_, err := svc.CreateAutoScalingGroup(params)
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok {
+				return errors.New(awsErr.Message())
 			}
+			return err
 		}
+
+		terminal.Delta("Created AutoScaling Group [" + aws.StringValue(params.AutoScalingGroupName) + "] in [" + region + "]!")
+
+		terminal.Information("Done!")
+	} else {
+		terminal.Notice("Params:")
+		fmt.Println(params.String())
 	}
 
 	return nil
-
 }
 
 // CreateAutoScaleAlarm creates a new CloudWatch Alarm given the provided class
@@ -499,8 +579,9 @@ func createAutoScaleAlarms(name string, cfg config.AlarmClass, asgList *AutoScal
 	return nil
 }
 
-// UpdateAutoScaleGroups updates existing AutoScale Groups that match the given search term to the provided version of Launch Configuration
-func UpdateAutoScaleGroups(name, version string, double, forceYes, dryRun bool) (err error) {
+// UpdateAutoScaleGroups updates existing AutoScale Groups that match the given search term to the provided version of Launch Configuration,
+// or writes the changes to a plan file to be applied later
+func UpdateAutoScaleGroups(name, version, planOut string, double, forceYes, dryRun bool) (err error) {
 
 	// --dry-run flag
 	if dryRun {
@@ -521,13 +602,32 @@ func UpdateAutoScaleGroups(name, version string, double, forceYes, dryRun bool)
 		return errors.New("No AutoScaling Groups found, Aborting!")
 	}
 
+	changes, err := asgList.Changes(version, double)
+	if err != nil {
+		return err
+	}
+
+	// --plan-out flag
+	if planOut != "" {
+		plan := Plan{Command: "updateAutoScaleGroups"}
+		for i := range changes {
+			plan.Changes = append(plan.Changes, PlanChange{
+				AssetType:            "autoscalegroups",
+				Asset:                changes[i].AutoScaleGroup.Name,
+				Region:               changes[i].AutoScaleGroup.Region,
+				AutoScaleGroupChange: &changes[i],
+			})
+		}
+		return plan.Write(planOut)
+	}
+
 	// Confirm
 	if !forceYes && !terminal.PromptBool("Are you sure you want to update these AutoScaling Groups?") {
 		return errors.New("Aborting!")
 	}
 
 	// Update 'Em
-	err = updateAutoScaleGroups(asgList, version, double, dryRun)
+	err = updateAutoScaleGroups(changes, dryRun)
 	if err == nil {
 		terminal.Information("Done!")
 	}
@@ -535,15 +635,31 @@ func UpdateAutoScaleGroups(name, version string, double, forceYes, dryRun bool)
 	return
 }
 
-// Private function without the confirmation terminal prompts
-func updateAutoScaleGroups(asgList *AutoScaleGroups, version string, double, dryRun bool) (err error) {
+// AutoScaleGroupChange represents an update of a single AutoScaling Group in one region, or the Alarms to create for it
+type AutoScaleGroupChange struct {
+	AutoScaleGroup          AutoScaleGroup
+	Region                  string
+	LaunchConfigurationName string
+	Class                   config.AutoscaleGroupClass
+	Params                  *autoscaling.UpdateAutoScalingGroupInput
+	Alarms                  []AutoScaleGroupAlarm
+}
 
-	for _, asg := range *asgList {
+// AutoScaleGroupAlarm represents an Alarm class to create for an AutoScaling Group
+type AutoScaleGroupAlarm struct {
+	Name  string
+	Class config.AlarmClass
+}
+
+// Changes works out the updates needed to bring AutoScale Groups to their classes and the provided version of Launch Configuration
+func (a AutoScaleGroups) Changes(version string, double bool) (changes []AutoScaleGroupChange, err error) {
+
+	for _, asg := range a {
 
 		// Get the ASG class config
 		cfg, err := config.LoadAutoscalingGroupClass(asg.Class)
 		if err != nil {
-			return err
+			return changes, err
 		}
 
 		// --double flag
@@ -557,14 +673,14 @@ func updateAutoScaleGroups(asgList *AutoScaleGroups, version string, double, dry
 		// Get the Launch Configuration class config
 		launchConfigurationCfg, err := config.LoadLaunchConfigurationClass(cfg.LaunchConfigurationClass)
 		if err != nil {
-			return err
+			return changes, err
 		}
 
 		// Set passed version early
 		if version != "" {
 			lcVer, err := strconv.Atoi(version)
 			if err != nil {
-				return err
+				return changes, err
 			}
 			launchConfigurationCfg.Version = lcVer
 			terminal.Information(fmt.Sprintf("Using Launch Configuration version [%d] passed in as an argument.", launchConfigurationCfg.Version))
@@ -575,7 +691,7 @@ func updateAutoScaleGroups(asgList *AutoScaleGroups, version string, double, dry
 		// Get the AZs
 		azs, errs := regions.GetAZs()
 		if errs != nil {
-			return errors.New("Error gathering region list")
+			return changes, errors.New("Error gathering region list")
 		}
 
 		for region, regionAZs := range azs.GetRegionMap(cfg.AvailabilityZones) {
@@ -585,13 +701,10 @@ func updateAutoScaleGroups(asgList *AutoScaleGroups, version string, double, dry
 			// Verify that the latest Launch Configuration is available in this region
 			lcName := GetLaunchConfigurationName(region, cfg.LaunchConfigurationClass, launchConfigurationCfg.Version)
 			if lcName == "" {
-				return fmt.Errorf("Launch Configuration [%s] version [%d] is not available in [%s]!", cfg.LaunchConfigurationClass, launchConfigurationCfg.Version, region)
+				return changes, fmt.Errorf("Launch Configuration [%s] version [%d] is not available in [%s]!", cfg.LaunchConfigurationClass, launchConfigurationCfg.Version, region)
 			}
 			terminal.Information(fmt.Sprintf("Found Launch Configuration [%s] version [%d] in [%s]", cfg.LaunchConfigurationClass, launchConfigurationCfg.Version, asg.Region))
 
-			sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
-			svc := autoscaling.New(sess)
-
 			params := &autoscaling.UpdateAutoScalingGroupInput{
 				AutoScalingGroupName:    aws.String(asg.Name),
 				DefaultCooldown:         aws.Int64(int64(cfg.DefaultCooldown)),
@@ -611,14 +724,14 @@ func updateAutoScaleGroups(asgList *AutoScaleGroups, version string, double, dry
 			if cfg.SubnetClass != "" {
 				err := GetRegionSubnets(region, subList, "")
 				if err != nil {
-					return err
+					return changes, err
 				}
 			}
 
 			// Set the AZs
 			for _, az := range regionAZs {
 				if !azs.ValidAZ(az) {
-					return cli.NewExitError("Availability Zone ["+az+"] is Invalid!", 1)
+					return changes, cli.NewExitError("Availability Zone ["+az+"] is Invalid!", 1)
 				}
 				terminal.Information("Found Availability Zone [" + az + "]!")
 
@@ -640,6 +753,67 @@ func updateAutoScaleGroups(asgList *AutoScaleGroups, version string, double, dry
 				params.TerminationPolicies = append(params.TerminationPolicies, aws.String(terminationPolicy)) // ??
 			}
 
+			changes = append(changes, AutoScaleGroupChange{
+				AutoScaleGroup:          asg,
+				Region:                  region,
+				LaunchConfigurationName: lcName,
+				Class:                   cfg,
+				Params:                  params,
+			})
+		}
+
+		// Create the Alarms and Scaling Policies
+		if len(cfg.Alarms) > 0 {
+			change := AutoScaleGroupChange{
+				AutoScaleGroup: asg,
+				Region:         asg.Region,
+			}
+
+			for _, alarm := range cfg.Alarms {
+
+				alarmCfg, err := config.LoadAlarmClass(alarm)
+				if err != nil {
+					return changes, err
+				}
+				terminal.Information("Found CloudWatch Alarm class configuration for [" + alarm + "]")
+
+				change.Alarms = append(change.Alarms, AutoScaleGroupAlarm{Name: alarm, Class: alarmCfg})
+			}
+
+			changes = append(changes, change)
+		}
+
+	}
+
+	return changes, nil
+}
+
+// Private function without the confirmation terminal prompts
+func updateAutoScaleGroups(changes []AutoScaleGroupChange, dryRun bool) (err error) {
+
+	for _, change := range changes {
+
+		asg := change.AutoScaleGroup
+		region := change.Region
+		cfg := change.Class
+		lcName := change.LaunchConfigurationName
+
+		if params := change.Params; params != nil {
+
+			sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+			svc := autoscaling.New(sess)
+
+			// Set the Mixed Instances Policy
+			if len(cfg.InstanceTypeOverrides) > 0 {
+				launchTemplateName, err := createLaunchTemplateFromLaunchConfiguration(lcName, region, dryRun)
+				if err != nil {
+					return err
+				}
+
+				params.LaunchConfigurationName = nil
+				params.MixedInstancesPolicy = mixedInstancesPolicy(cfg, launchTemplateName)
+			}
+
 			// Update it!
 			if !dryRun {
 				_, err := svc.UpdateAutoScalingGroup(params)
@@ -689,27 +863,17 @@ func updateAutoScaleGroups(asgList *AutoScaleGroups, version string, double, dry
 		}
 
 		// Create the Alarms and Scaling Policies
-		if len(cfg.Alarms) > 0 {
-
-			asgList := &AutoScaleGroups{
-				AutoScaleGroup{
-					Name:   asg.Name,
-					Region: asg.Region,
-				},
-			}
-
-			for _, alarm := range cfg.Alarms {
-
-				alarmCfg, err := config.LoadAlarmClass(alarm)
-				if err != nil {
-					return err
-				}
-				terminal.Information("Found CloudWatch Alarm class configuration for [" + alarm + "]")
+		asgList := &AutoScaleGroups{
+			AutoScaleGroup{
+				Name:   asg.Name,
+				Region: asg.Region,
+			},
+		}
 
-				err = createAutoScaleAlarms(alarm, alarmCfg, asgList, dryRun)
-				if err != nil {
-					return err
-				}
+		for _, alarm := range change.Alarms {
+			err = createAutoScaleAlarms(alarm.Name, alarm.Class, asgList, dryRun)
+			if err != nil {
+				return err
 			}
 		}
 
@@ -0,0 +1,27 @@
+package aws
+
+import "testing"
+
+func TestIsClassAutoScaleGroup(t *testing.T) {
+	tests := []struct {
+		name  string
+		class string
+		want  bool
+	}{
+		{name: "web", class: "web", want: true},
+		{name: "web-v3", class: "web", want: true},
+		{name: "web-v12", class: "web", want: true},
+		{name: "web-v3", class: "web-v3", want: true},
+		{name: "web-v", class: "web", want: false},
+		{name: "web-v3-v4", class: "web", want: false},
+		{name: "webapp-v2", class: "web", want: false},
+		{name: "web-api-v2", class: "web", want: false},
+		{name: "web", class: "web-api", want: false},
+	}
+
+	for _, test := range tests {
+		if got := isClassAutoScaleGroup(test.name, test.class); got != test.want {
+			t.Errorf("isClassAutoScaleGroup(%q, %q) = %t, want %t", test.name, test.class, got, test.want)
+		}
+	}
+}
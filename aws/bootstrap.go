@@ -0,0 +1,891 @@
+package aws
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mitchellh/hashstructure"
+	"github.com/murdinc/awsm/aws/regions"
+	"github.com/murdinc/awsm/config"
+	"github.com/murdinc/awsm/models"
+	"github.com/murdinc/terminal"
+	"github.com/olekukonko/tablewriter"
+)
+
+// BootstrapSteps represents the dependency ordered list of assets needed by an AutoScaling Group class
+type BootstrapSteps []BootstrapStep
+
+// BootstrapStep represents a single asset needed by an AutoScaling Group class
+type BootstrapStep models.BootstrapStep
+
+// bootstrapNode is a single asset in the dependency graph of an AutoScaling Group class, create is nil for assets that bootstrap can not create
+type bootstrapNode struct {
+	step   BootstrapStep
+	deps   []string
+	create func() error
+}
+
+// bootstrapGraph is the dependency graph of an AutoScaling Group class in a single region, along with the current state of that region
+type bootstrapGraph struct {
+	region string
+	keys   []string
+	nodes  map[string]*bootstrapNode
+
+	vpcClass  string
+	vpcKey    string
+	vpcCIDR   string
+	vpc       Vpc
+	usedCIDRs []string
+
+	subnets    *Subnets
+	subnetKeys map[string][]string
+	secGrps    *SecurityGroups
+}
+
+// Bootstrap creates every asset that an AutoScaling Group class depends on and that does not exist yet in a region, in dependency order
+func Bootstrap(class, region, ip string, dryRun bool) error {
+
+	// --dry-run flag
+	if dryRun {
+		terminal.Information("--dry-run flag is set, not making any actual changes!")
+	}
+
+	// Validate the region
+	if !regions.ValidRegion(region) {
+		return errors.New("Region [" + region + "] is Invalid!")
+	}
+
+	graph, err := newBootstrapGraph(class, region, ip)
+	if err != nil {
+		return err
+	}
+
+	nodes, err := graph.sort()
+	if err != nil {
+		return err
+	}
+
+	steps := make(BootstrapSteps, len(nodes))
+	creates, missing := 0, 0
+	for i, node := range nodes {
+		steps[i] = node.step
+		switch node.step.Action {
+		case "Create":
+			creates++
+		case "Missing":
+			missing++
+		}
+	}
+
+	steps.PrintTable()
+
+	if missing > 0 {
+		return errors.New("Some assets can not be created by bootstrap and need to exist before it can continue, Aborting!")
+	}
+
+	if creates == 0 {
+		terminal.Information("Every asset of AutoScaling Group class [" + class + "] already exists in [" + region + "]!")
+		return nil
+	}
+
+	if dryRun {
+		return nil
+	}
+
+	// Confirm
+	if !terminal.PromptBool(fmt.Sprintf("Are you sure you want to create these %d assets?", creates)) {
+		return errors.New("Aborting!")
+	}
+
+	for _, node := range nodes {
+		if node.step.Action != "Create" {
+			continue
+		}
+
+		terminal.Delta(fmt.Sprintf("Step %d - Creating %s [%s] in [%s]", node.step.Step, node.step.AssetType, node.step.Class, region))
+
+		err := node.create()
+		if err != nil {
+			return fmt.Errorf("Bootstrap stopped at step %d, creating %s [%s]: %s", node.step.Step, node.step.AssetType, node.step.Class, err)
+		}
+	}
+
+	terminal.Information("Done!")
+
+	return nil
+}
+
+// newBootstrapGraph builds the dependency graph of an AutoScaling Group class from the references between its classes
+func newBootstrapGraph(class, region, ip string) (*bootstrapGraph, error) {
+
+	g := &bootstrapGraph{
+		region:     region,
+		nodes:      make(map[string]*bootstrapNode),
+		subnets:    new(Subnets),
+		subnetKeys: make(map[string][]string),
+		secGrps:    new(SecurityGroups),
+	}
+
+	// Class Configs
+	asgCfg, err := config.LoadAutoscalingGroupClass(class)
+	if err != nil {
+		return g, err
+	}
+	terminal.Information("Found Autoscaling group class configuration for [" + class + "]")
+
+	lcClass := asgCfg.LaunchConfigurationClass
+	lcCfg, err := config.LoadLaunchConfigurationClass(lcClass)
+	if err != nil {
+		return g, err
+	}
+	terminal.Information("Found Launch Configuration class configuration for [" + lcClass + "]")
+
+	inRegions := false
+	for _, r := range lcCfg.Regions {
+		if r == region {
+			inRegions = true
+		}
+	}
+	if !inRegions {
+		return g, errors.New("Launch Configuration class [" + lcClass + "] does not include the region [" + region + "]!")
+	}
+
+	instanceCfg, err := config.LoadInstanceClass(lcCfg.InstanceClass)
+	if err != nil {
+		return g, err
+	}
+	terminal.Information("Found Instance class configuration for [" + lcCfg.InstanceClass + "]")
+
+	lbCfgs := make(map[string]config.LoadBalancerClass)
+	for _, name := range asgCfg.LoadBalancerNames {
+		lbCfg, err := config.LoadLoadBalancerClass(name)
+		if err != nil {
+			return g, err
+		}
+		lbCfgs[name] = lbCfg
+	}
+
+	azs, errs := regions.GetAZs()
+	if errs != nil {
+		return g, errors.New("Error gathering region list")
+	}
+
+	regionAZs := azs.GetRegionMap(asgCfg.AvailabilityZones)[region]
+	if len(regionAZs) == 0 {
+		return g, errors.New("AutoScaling Group class [" + class + "] has no Availability Zones in [" + region + "]!")
+	}
+
+	// Every asset of the stack lives in the same VPC
+	g.vpcClass = instanceCfg.Vpc
+	for _, name := range asgCfg.LoadBalancerNames {
+		lbVpc := lbCfgs[name].Vpc
+		if lbVpc == "" {
+			continue
+		}
+		if g.vpcClass == "" {
+			g.vpcClass = lbVpc
+		} else if lbVpc != g.vpcClass {
+			return g, errors.New("Load Balancer class [" + name + "] uses VPC class [" + lbVpc + "] instead of [" + g.vpcClass + "]!")
+		}
+	}
+
+	if asgCfg.SubnetClass != "" && g.vpcClass == "" {
+		return g, errors.New("AutoScaling Group class [" + class + "] uses Subnet class [" + asgCfg.SubnetClass + "] but none of its Instance or Load Balancer classes have a VPC class!")
+	}
+
+	// Current state of the region
+	terminal.Notice("Gathering the assets in [" + region + "]...")
+
+	vpcList := new(Vpcs)
+	err = GetRegionVpcs(region, vpcList, "")
+	if err != nil {
+		return g, err
+	}
+
+	err = GetRegionSubnets(region, g.subnets, "")
+	if err != nil {
+		return g, err
+	}
+
+	err = GetRegionSecurityGroups(region, g.secGrps, "")
+	if err != nil {
+		return g, err
+	}
+
+	lbList := new(LoadBalancers)
+	err = GetRegionLoadBalancers(region, lbList, "")
+	if err != nil {
+		return g, err
+	}
+
+	asgList := new(AutoScaleGroups)
+	err = GetRegionAutoScaleGroups(region, asgList, "")
+	if err != nil {
+		return g, err
+	}
+
+	spList := new(ScalingPolicies)
+	err = GetRegionScalingPolicies(region, spList, "")
+	if err != nil {
+		return g, err
+	}
+
+	alList := new(Alarms)
+	err = GetRegionAlarms(region, alList, "")
+	if err != nil {
+		return g, err
+	}
+
+	// VPC
+	if g.vpcClass != "" {
+		err = g.addVpc(vpcList, ip)
+		if err != nil {
+			return g, err
+		}
+	}
+
+	// Instance Subnet and Security Groups
+	var instanceDeps []string
+	if g.vpcKey != "" {
+		instanceDeps = append(instanceDeps, g.vpcKey)
+
+		if instanceCfg.Subnet != "" {
+			keys, err := g.addSubnets(instanceCfg.Subnet, regionAZs, true)
+			if err != nil {
+				return g, err
+			}
+			instanceDeps = append(instanceDeps, keys...)
+		}
+	}
+
+	for _, secGrp := range instanceCfg.SecurityGroups {
+		key, err := g.addSecurityGroup(secGrp)
+		if err != nil {
+			return g, err
+		}
+		instanceDeps = append(instanceDeps, key)
+	}
+
+	// KeyPair
+	if instanceCfg.KeyName != "" {
+		instanceDeps = append(instanceDeps, g.addKeyPair(instanceCfg.KeyName))
+	}
+
+	// Load Balancers
+	var lbKeys []string
+	for _, name := range asgCfg.LoadBalancerNames {
+		key, err := g.addLoadBalancer(name, lbCfgs[name], lbList, regionAZs)
+		if err != nil {
+			return g, err
+		}
+		lbKeys = append(lbKeys, key)
+	}
+
+	// Launch Configuration
+	lcKey, err := g.addLaunchConfiguration(lcClass, lcCfg, instanceCfg, instanceDeps)
+	if err != nil {
+		return g, err
+	}
+
+	// AutoScaling Group
+	asgDeps := append([]string{lcKey}, lbKeys...)
+	if asgCfg.SubnetClass != "" {
+		keys, err := g.addSubnets(asgCfg.SubnetClass, regionAZs, false)
+		if err != nil {
+			return g, err
+		}
+		asgDeps = append(asgDeps, keys...)
+	}
+
+	// A deployed group is named after its Launch Configuration version
+	asgName := class
+	asgExists := false
+	for _, asg := range *asgList {
+		if isClassAutoScaleGroup(asg.Name, class) {
+			asgName = asg.Name
+			asgExists = true
+		}
+	}
+
+	asgKey := "autoscalegroup/" + class
+	g.add(asgKey, "autoscalegroup", class, strings.Join(regionAZs, ", "), asgExists, func() error {
+		lcCfg, err := config.LoadLaunchConfigurationClass(lcClass)
+		if err != nil {
+			return err
+		}
+		return createAutoScaleGroup(class, asgCfg, lcCfg, region, regionAZs, azs, false)
+	}, asgDeps...)
+
+	// Scaling Policies and Alarms
+	for _, alarm := range asgCfg.Alarms {
+		err := g.addAlarm(alarm, asgName, asgKey, spList, alList)
+		if err != nil {
+			return g, err
+		}
+	}
+
+	return g, nil
+}
+
+// add adds a node to the graph unless it is already there
+func (g *bootstrapGraph) add(key, assetType, class, detail string, exists bool, create func() error, deps ...string) {
+
+	if _, ok := g.nodes[key]; ok {
+		return
+	}
+
+	action := "Exists"
+	if !exists {
+		action = "Create"
+		if create == nil {
+			action = "Missing"
+		}
+	}
+
+	// Dedupe the dependencies
+	seen := make(map[string]bool)
+	var unique []string
+	for _, dep := range deps {
+		if dep != "" && !seen[dep] {
+			seen[dep] = true
+			unique = append(unique, dep)
+		}
+	}
+
+	g.keys = append(g.keys, key)
+	g.nodes[key] = &bootstrapNode{
+		step: BootstrapStep{
+			AssetType: assetType,
+			Class:     class,
+			Detail:    detail,
+			Action:    action,
+			Region:    g.region,
+		},
+		deps:   unique,
+		create: create,
+	}
+}
+
+// sort returns the nodes of the graph in topological order, dependencies first
+func (g *bootstrapGraph) sort() ([]*bootstrapNode, error) {
+
+	var order []*bootstrapNode
+	state := make(map[string]int) // 1 = visiting, 2 = done
+
+	var visit func(key string) error
+	visit = func(key string) error {
+		node, ok := g.nodes[key]
+		if !ok {
+			return errors.New("Unknown dependency [" + key + "]!")
+		}
+
+		switch state[key] {
+		case 1:
+			return errors.New("Found a dependency cycle at [" + key + "], Aborting!")
+		case 2:
+			return nil
+		}
+
+		state[key] = 1
+		for _, dep := range node.deps {
+			err := visit(dep)
+			if err != nil {
+				return err
+			}
+		}
+		state[key] = 2
+
+		node.step.Step = len(order) + 1
+		node.step.DependsOn = node.deps
+		order = append(order, node)
+
+		return nil
+	}
+
+	for _, key := range g.keys {
+		err := visit(key)
+		if err != nil {
+			return order, err
+		}
+	}
+
+	return order, nil
+}
+
+// addVpc adds the VPC node, a missing VPC is created from the provided base ip and the CIDR mask of its class
+func (g *bootstrapGraph) addVpc(vpcList *Vpcs, ip string) error {
+
+	vpcCfg, err := config.LoadVpcClass(g.vpcClass)
+	if err != nil {
+		return err
+	}
+	terminal.Information("Found VPC Class Configuration for [" + g.vpcClass + "]!")
+
+	g.vpcKey = "vpc/" + g.vpcClass
+
+	for _, vpc := range *vpcList {
+		if vpc.Class == g.vpcClass {
+			g.vpc = vpc
+			g.vpcCIDR = vpc.CIDRBlock
+
+			for _, subnet := range *g.subnets {
+				if subnet.VpcID == vpc.VpcID {
+					g.usedCIDRs = append(g.usedCIDRs, subnet.CIDRBlock)
+				}
+			}
+
+			g.add(g.vpcKey, "vpc", g.vpcClass, vpc.VpcID+" "+vpc.CIDRBlock, true, nil)
+			return nil
+		}
+	}
+
+	if ip == "" {
+		return errors.New("VPC class [" + g.vpcClass + "] does not exist in [" + g.region + "] yet, please provide an --ip to create it with!")
+	}
+
+	vpcClass, region := g.vpcClass, g.region
+	g.vpcCIDR = ip + vpcCfg.CIDR
+	g.add(g.vpcKey, "vpc", vpcClass, g.vpcCIDR, false, func() error {
+		return CreateVpc(vpcClass, vpcClass, ip, region, false)
+	})
+
+	return nil
+}
+
+// addSubnets adds a Subnet node of a class for each of the given availability zones. When anyZone is set a single Subnet of the class
+// anywhere in the VPC is enough, since Instances and Load Balancers look up their Subnets by class only.
+func (g *bootstrapGraph) addSubnets(subnetClass string, zones []string, anyZone bool) (keys []string, err error) {
+
+	subnetCfg, err := config.LoadSubnetClass(subnetClass)
+	if err != nil {
+		return keys, err
+	}
+
+	existing := make(map[string]Subnet)
+	var first Subnet
+	for _, subnet := range *g.subnets {
+		if g.vpc.VpcID != "" && subnet.VpcID == g.vpc.VpcID && subnet.Class == subnetClass {
+			existing[subnet.AvailabilityZone] = subnet
+			if first.SubnetID == "" {
+				first = subnet
+			}
+		}
+	}
+
+	if anyZone {
+		if len(g.subnetKeys[subnetClass]) > 0 {
+			return g.subnetKeys[subnetClass], nil
+		}
+		if first.SubnetID != "" {
+			zones = []string{first.AvailabilityZone}
+		} else {
+			zones = zones[:1]
+		}
+	}
+
+	for _, az := range zones {
+		key := "subnet/" + subnetClass + "/" + az
+		keys = append(keys, key)
+
+		if _, ok := g.nodes[key]; ok {
+			continue
+		}
+		g.subnetKeys[subnetClass] = append(g.subnetKeys[subnetClass], key)
+
+		if subnet, ok := existing[az]; ok {
+			g.add(key, "subnet", subnetClass, subnet.SubnetID+" "+subnet.CIDRBlock+" "+az, true, nil, g.vpcKey)
+			continue
+		}
+
+		cidr, err := nextSubnetCIDR(g.vpcCIDR, subnetCfg.CIDR, g.usedCIDRs)
+		if err != nil {
+			return keys, err
+		}
+		g.usedCIDRs = append(g.usedCIDRs, cidr)
+
+		g.add(key, "subnet", subnetClass, cidr+" "+az, false, g.createSubnet(subnetClass, strings.Split(cidr, "/")[0], az), g.vpcKey)
+	}
+
+	return keys, nil
+}
+
+// createSubnet returns a function that creates a Subnet in the VPC, looking up the VPC at the time it runs since it might have just been created
+func (g *bootstrapGraph) createSubnet(subnetClass, ip, az string) func() error {
+	return func() error {
+		vpc, err := GetRegionVpcByTag(g.region, "Class", g.vpcClass)
+		if err != nil {
+			return err
+		}
+		return CreateSubnet(subnetClass, subnetClass, vpc.VpcID, ip, az, false)
+	}
+}
+
+// addSecurityGroup adds a Security Group node that creates the group without the grants from other Security Groups, and when the class
+// has any of those, a later node that authorizes them once every group it references exists. Groups that grant each other only
+// depend on each other through their grants nodes, so mutual grants never make a cycle. Returns the key that dependents should use.
+func (g *bootstrapGraph) addSecurityGroup(name string) (string, error) {
+
+	key := "securitygroup/" + name
+	grantsKey := "securitygroupgrants/" + name
+
+	if _, ok := g.nodes[grantsKey]; ok {
+		return grantsKey, nil
+	}
+	if _, ok := g.nodes[key]; ok {
+		return key, nil
+	}
+
+	cfg, err := config.LoadSecurityGroupClass(name, true)
+	if err != nil {
+		return key, err
+	}
+
+	var existing *SecurityGroup
+	for i, secGrp := range *g.secGrps {
+		if secGrp.Class == name && (g.vpcClass == "" || (g.vpc.VpcID != "" && secGrp.VpcID == g.vpc.VpcID)) {
+			existing = &(*g.secGrps)[i]
+			break
+		}
+	}
+
+	region := g.region
+	vpcClass := g.vpcClass
+
+	if existing != nil {
+		g.add(key, "securitygroup", name, existing.GroupID, true, nil, g.vpcKey)
+	} else {
+		g.add(key, "securitygroup", name, "", false, func() error {
+			vpcID := ""
+			if vpcClass != "" {
+				vpc, err := GetRegionVpcByTag(region, "Class", vpcClass)
+				if err != nil {
+					return err
+				}
+				vpcID = vpc.VpcID
+			}
+			return createSecurityGroup(name, region, vpcID, false, false)
+		}, g.vpcKey)
+	}
+
+	// Grants from other Security Groups
+	var groupGrants []config.SecurityGroupGrant
+	var sources []string
+	for _, grant := range cfg.SecurityGroupGrants {
+		if len(grant.SourceSecurityGroupNames) == 0 {
+			continue
+		}
+		groupGrants = append(groupGrants, grant)
+		sources = append(sources, grant.SourceSecurityGroupNames...)
+	}
+
+	if len(groupGrants) == 0 {
+		return key, nil
+	}
+
+	// Add the grants node before following the sources, so that a source that grants this group back finds it
+	g.add(grantsKey, "securitygroupgrants", name, strings.Join(sources, ", "), existing != nil && hasSecurityGroupGrants(*existing, groupGrants), func() error {
+		vpcID := ""
+		if vpcClass != "" {
+			vpc, err := GetRegionVpcByTag(region, "Class", vpcClass)
+			if err != nil {
+				return err
+			}
+			vpcID = vpc.VpcID
+		}
+		return authorizeSecurityGroupGroupGrants(name, region, vpcID)
+	}, key)
+
+	// Other Security Group classes need to exist before they can be granted, other group names must already exist
+	for _, source := range sources {
+		if source == name {
+			continue
+		}
+		if _, err := config.LoadSecurityGroupClass(source, false); err != nil {
+			continue
+		}
+
+		sourceKey := "securitygroup/" + source
+		if _, err := g.addSecurityGroup(source); err != nil {
+			return grantsKey, err
+		}
+		g.nodes[grantsKey].deps = appendUnique(g.nodes[grantsKey].deps, sourceKey)
+	}
+
+	return grantsKey, nil
+}
+
+// hasSecurityGroupGrants returns true if a Security Group already has every one of the given grants
+func hasSecurityGroupGrants(secGrp SecurityGroup, grants []config.SecurityGroupGrant) bool {
+
+	existing := make(map[uint64]bool)
+	for _, grant := range secGrp.SecurityGroupGrants {
+		for _, sGrant := range splitSecurityGroupGrant(grant) {
+			hash, err := hashstructure.Hash(sGrant, nil)
+			if err == nil {
+				existing[hash] = true
+			}
+		}
+	}
+
+	for _, grant := range grants {
+		for _, sGrant := range splitSecurityGroupGrant(grant) {
+			hash, err := hashstructure.Hash(sGrant, nil)
+			if err != nil || !existing[hash] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// appendUnique appends a string to a list unless it is already in it
+func appendUnique(list []string, s string) []string {
+	for _, item := range list {
+		if item == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
+
+// addKeyPair adds a KeyPair node, KeyPairs that are not classes can only be checked for
+func (g *bootstrapGraph) addKeyPair(class string) string {
+
+	key := "keypair/" + class
+	keyName := currentKeyPairName(class)
+	_, err := GetKeyPairByName(g.region, keyName)
+
+	var create func() error
+	if _, cfgErr := config.LoadKeyPairClass(class); cfgErr == nil {
+		region := g.region
+		create = func() error {
+			return CreateKeyPair(class, region, false)
+		}
+	}
+
+	g.add(key, "keypair", class, keyName, err == nil, create)
+
+	return key
+}
+
+// addLoadBalancer adds a Load Balancer node along with its Subnets and Security Groups
+func (g *bootstrapGraph) addLoadBalancer(name string, cfg config.LoadBalancerClass, lbList *LoadBalancers, zones []string) (string, error) {
+
+	key := "loadbalancer/" + name
+	deps := []string{g.vpcKey}
+
+	// Subnets and Security Groups are only looked up for Load Balancers in a VPC
+	if cfg.Vpc != "" {
+		for _, subnetClass := range cfg.Subnets {
+			keys, err := g.addSubnets(subnetClass, zones, true)
+			if err != nil {
+				return key, err
+			}
+			deps = append(deps, keys...)
+		}
+
+		for _, secGrp := range cfg.SecurityGroups {
+			secGrpKey, err := g.addSecurityGroup(secGrp)
+			if err != nil {
+				return key, err
+			}
+			deps = append(deps, secGrpKey)
+		}
+	}
+
+	exists := false
+	for _, lb := range *lbList {
+		if lb.Name == name {
+			exists = true
+		}
+	}
+
+	region := g.region
+	g.add(key, "loadbalancer", name, cfg.Scheme, exists, func() error {
+		return CreateLoadBalancer(name, region, false)
+	}, deps...)
+
+	return key, nil
+}
+
+// addLaunchConfiguration adds a Launch Configuration node, along with the AMI and Snapshots it needs when it does not exist yet
+func (g *bootstrapGraph) addLaunchConfiguration(class string, cfg config.LaunchConfigurationClass, instanceCfg config.InstanceClass, deps []string) (string, error) {
+
+	key := "launchconfiguration/" + class
+
+	if cfg.Version > 0 {
+		if name := GetLaunchConfigurationName(g.region, class, cfg.Version); name != "" {
+			g.add(key, "launchconfiguration", class, name, true, nil, deps...)
+			return key, nil
+		}
+	}
+
+	// AMI
+	if instanceCfg.AMI != "" {
+		imageKey := "image/" + instanceCfg.AMI
+		image, err := GetLatestImageByTag(g.region, "Class", instanceCfg.AMI)
+		g.add(imageKey, "image", instanceCfg.AMI, image.ImageID, err == nil, nil)
+		deps = append(deps, imageKey)
+	}
+
+	// EBS Snapshots
+	for _, ebsClass := range instanceCfg.EBSVolumes {
+		volCfg, err := config.LoadVolumeClass(ebsClass)
+		if err != nil {
+			return key, err
+		}
+
+		snapshotKey := "snapshot/" + volCfg.Snapshot
+		snapshot, err := GetLatestSnapshotByTag(g.region, "Class", volCfg.Snapshot)
+		g.add(snapshotKey, "snapshot", volCfg.Snapshot, snapshot.SnapshotID, err == nil, nil)
+		deps = append(deps, snapshotKey)
+	}
+
+	version := cfg.Version
+	if version == 0 {
+		version = 1
+	}
+
+	region := g.region
+	g.add(key, "launchconfiguration", class, fmt.Sprintf("%s-v%d", class, version), false, func() error {
+		cfg, err := config.LoadLaunchConfigurationClass(class)
+		if err != nil {
+			return err
+		}
+
+		// A class that was never built gets its first version, otherwise the current version is built in this region only
+		if cfg.Version == 0 {
+			err = cfg.Increment(class)
+			if err != nil {
+				return err
+			}
+		}
+
+		params, err := launchConfigurationInput(class, cfg.Version, instanceCfg)
+		if err != nil {
+			return err
+		}
+
+		return createLaunchConfiguration(class, cfg, instanceCfg, params, region, false)
+	}, deps...)
+
+	return key, nil
+}
+
+// addAlarm adds an Alarm node along with the Scaling Policy classes in its Alarm Actions
+func (g *bootstrapGraph) addAlarm(alarm, asgName, asgKey string, spList *ScalingPolicies, alList *Alarms) error {
+
+	alarmCfg, err := config.LoadAlarmClass(alarm)
+	if err != nil {
+		return err
+	}
+
+	asg := &AutoScaleGroups{AutoScaleGroup{Name: asgName, Region: g.region}}
+	deps := []string{asgKey}
+
+	for _, action := range alarmCfg.AlarmActions {
+		policyCfg, err := config.LoadScalingPolicyClass(action)
+		if err != nil {
+			continue
+		}
+
+		policyKey := "scalingpolicy/" + action
+		policyExists := false
+		for _, policy := range *spList {
+			if policy.Name == action && policy.AutoScaleGroupName == asgName {
+				policyExists = true
+			}
+		}
+
+		policy := action
+		g.add(policyKey, "scalingpolicy", policy, asgName, policyExists, func() error {
+			_, err := createScalingPolicy(policy, policyCfg, asg, false)
+			return err
+		}, asgKey)
+		deps = append(deps, policyKey)
+	}
+
+	alarmExists := false
+	for _, al := range *alList {
+		if al.Name == alarm {
+			alarmExists = true
+		}
+	}
+
+	g.add("alarm/"+alarm, "alarm", alarm, alarmCfg.MetricName, alarmExists, func() error {
+		return createAutoScaleAlarms(alarm, alarmCfg, asg, false)
+	}, deps...)
+
+	return nil
+}
+
+// nextSubnetCIDR returns the first block of the given mask inside a VPC CIDR that does not overlap any of the used CIDRs
+func nextSubnetCIDR(vpcCIDR, mask string, used []string) (string, error) {
+
+	_, vpcNet, err := net.ParseCIDR(vpcCIDR)
+	if err != nil || vpcNet.IP.To4() == nil {
+		return "", errors.New("VPC CIDR [" + vpcCIDR + "] is not a valid IPv4 CIDR!")
+	}
+	vpcBits, _ := vpcNet.Mask.Size()
+
+	bits, err := strconv.Atoi(strings.TrimPrefix(mask, "/"))
+	if err != nil || bits < vpcBits || bits > 32 {
+		return "", errors.New("Subnet CIDR [" + mask + "] does not fit inside of VPC CIDR [" + vpcCIDR + "]!")
+	}
+
+	var usedNets []*net.IPNet
+	for _, cidr := range used {
+		if _, n, err := net.ParseCIDR(cidr); err == nil {
+			usedNets = append(usedNets, n)
+		}
+	}
+
+	base := uint64(binary.BigEndian.Uint32(vpcNet.IP.To4()))
+	size := uint64(1) << uint(32-bits)
+
+	for block := uint64(0); block < uint64(1)<<uint(bits-vpcBits); block++ {
+		ip := make(net.IP, 4)
+		binary.BigEndian.PutUint32(ip, uint32(base+block*size))
+		candidate := &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, 32)}
+
+		free := true
+		for _, n := range usedNets {
+			if n.Contains(candidate.IP) || candidate.Contains(n.IP) {
+				free = false
+				break
+			}
+		}
+
+		if free {
+			return candidate.String(), nil
+		}
+	}
+
+	return "", errors.New("No free [" + mask + "] blocks left in VPC CIDR [" + vpcCIDR + "]!")
+}
+
+// PrintTable Prints an ascii table of the list of Bootstrap Steps
+func (b *BootstrapSteps) PrintTable() {
+	if len(*b) == 0 {
+		terminal.ShowErrorMessage("Warning", "No Bootstrap Steps Found!")
+		return
+	}
+
+	var header []string
+	rows := make([][]string, len(*b))
+
+	for index, step := range *b {
+		models.ExtractAwsmTable(index, step, &header, &rows)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(header)
+	table.AppendBulk(rows)
+	table.Render()
+}
@@ -0,0 +1,43 @@
+package aws
+
+import "testing"
+
+func TestNextSubnetCIDR(t *testing.T) {
+	tests := []struct {
+		vpcCIDR string
+		mask    string
+		used    []string
+		want    string
+		wantErr bool
+	}{
+		{vpcCIDR: "10.0.0.0/16", mask: "/24", want: "10.0.0.0/24"},
+		{vpcCIDR: "10.0.0.0/16", mask: "24", want: "10.0.0.0/24"},
+		{vpcCIDR: "10.0.0.0/16", mask: "/24", used: []string{"10.0.0.0/24"}, want: "10.0.1.0/24"},
+		{vpcCIDR: "10.0.0.0/16", mask: "/24", used: []string{"10.0.0.0/23"}, want: "10.0.2.0/24"},
+		{vpcCIDR: "10.0.0.0/16", mask: "/23", used: []string{"10.0.1.0/24"}, want: "10.0.2.0/23"},
+		{vpcCIDR: "10.0.0.0/16", mask: "/24", used: []string{"not a cidr", "10.0.0.0/24"}, want: "10.0.1.0/24"},
+		{vpcCIDR: "10.0.0.0/24", mask: "/25", used: []string{"10.0.0.0/25", "10.0.0.128/25"}, wantErr: true},
+		{vpcCIDR: "10.0.0.0/16", mask: "/8", wantErr: true},
+		{vpcCIDR: "10.0.0.0/16", mask: "/33", wantErr: true},
+		{vpcCIDR: "10.0.0.0/16", mask: "/big", wantErr: true},
+		{vpcCIDR: "not a cidr", mask: "/24", wantErr: true},
+		{vpcCIDR: "2001:db8::/56", mask: "/64", wantErr: true},
+	}
+
+	for _, test := range tests {
+		got, err := nextSubnetCIDR(test.vpcCIDR, test.mask, test.used)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("nextSubnetCIDR(%q, %q, %q) = %q, want an error", test.vpcCIDR, test.mask, test.used, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("nextSubnetCIDR(%q, %q, %q) returned an error: %s", test.vpcCIDR, test.mask, test.used, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("nextSubnetCIDR(%q, %q, %q) = %q, want %q", test.vpcCIDR, test.mask, test.used, got, test.want)
+		}
+	}
+}
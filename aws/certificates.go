@@ -0,0 +1,263 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/murdinc/awsm/aws/regions"
+	"github.com/murdinc/awsm/config"
+	"github.com/murdinc/awsm/models"
+	"github.com/murdinc/terminal"
+	"github.com/olekukonko/tablewriter"
+)
+
+// certificateExpiryWarningDays is how far ahead the check command warns about expiring certificates
+const certificateExpiryWarningDays = 30
+
+// Certificates represents a slice of ACM Certificates
+type Certificates []Certificate
+
+// Certificate represents a single ACM Certificate
+type Certificate models.Certificate
+
+// GetCertificates returns a slice of ACM Certificates that match the provided search term
+func GetCertificates(search string) (*Certificates, []error) {
+	var wg sync.WaitGroup
+	var errs []error
+
+	certList := new(Certificates)
+	regions := GetRegionListWithoutIgnored()
+
+	for _, region := range regions {
+		wg.Add(1)
+
+		go func(region *ec2.Region) {
+			defer wg.Done()
+			err := GetRegionCertificates(*region.RegionName, certList, search)
+			if err != nil {
+				terminal.ShowErrorMessage(fmt.Sprintf("Error gathering certificate list for region [%s]", *region.RegionName), err.Error())
+				errs = append(errs, err)
+			}
+		}(region)
+	}
+	wg.Wait()
+
+	return certList, errs
+}
+
+// GetRegionCertificates returns a list of a regions ACM Certificates into the provided Certificates slice
+func GetRegionCertificates(region string, certList *Certificates, search string) error {
+
+	// Validate the region
+	if !regions.ValidRegion(region) {
+		return errors.New("Region [" + region + "] is Invalid!")
+	}
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := acm.New(sess)
+
+	var arns []*string
+	err := svc.ListCertificatesPages(&acm.ListCertificatesInput{}, func(page *acm.ListCertificatesOutput, lastPage bool) bool {
+		for _, summary := range page.CertificateSummaryList {
+			arns = append(arns, summary.CertificateArn)
+		}
+		return true
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return errors.New(awsErr.Message())
+		}
+		return err
+	}
+
+	certs := make(Certificates, len(arns))
+	for i, arn := range arns {
+		resp, err := svc.DescribeCertificate(&acm.DescribeCertificateInput{
+			CertificateArn: arn,
+		})
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok {
+				return errors.New(awsErr.Message())
+			}
+			return err
+		}
+		certs[i].Marshal(resp.Certificate, region)
+	}
+
+	if search != "" {
+		term := regexp.MustCompile(search)
+	Loop:
+		for i, c := range certs {
+			rCert := reflect.ValueOf(c)
+
+			for k := 0; k < rCert.NumField(); k++ {
+				sVal := rCert.Field(k).String()
+
+				if term.MatchString(sVal) {
+					*certList = append(*certList, certs[i])
+					continue Loop
+				}
+			}
+		}
+	} else {
+		*certList = append(*certList, certs[:]...)
+	}
+
+	return nil
+}
+
+// Marshal parses the response from the aws sdk into an awsm Certificate
+func (c *Certificate) Marshal(cert *acm.CertificateDetail, region string) {
+	c.Domain = aws.StringValue(cert.DomainName)
+	c.Status = aws.StringValue(cert.Status)
+	c.Type = aws.StringValue(cert.Type)
+	c.NotAfter = aws.TimeValue(cert.NotAfter)
+	c.Arn = aws.StringValue(cert.CertificateArn)
+	c.Region = region
+
+	for _, name := range aws.StringValueSlice(cert.SubjectAlternativeNames) {
+		if name != c.Domain {
+			c.AlternativeNames = append(c.AlternativeNames, name)
+		}
+	}
+
+	// Just the resource names, the full ARNs are too wide for the table
+	for _, arn := range aws.StringValueSlice(cert.InUseBy) {
+		parts := strings.Split(arn, "/")
+		c.InUseBy = append(c.InUseBy, parts[len(parts)-1])
+	}
+}
+
+// matchesDomain returns true if the certificate covers the provided domain name, including through a wildcard
+func (c Certificate) matchesDomain(domain string) bool {
+	for _, name := range append([]string{c.Domain}, c.AlternativeNames...) {
+		if name == domain {
+			return true
+		}
+		if strings.HasPrefix(name, "*.") && strings.Count(domain, ".") > 1 && domain[strings.Index(domain, "."):] == name[1:] {
+			return true
+		}
+	}
+	return false
+}
+
+// PrintTable Prints an ascii table of the list of Certificates
+func (c *Certificates) PrintTable() {
+	if len(*c) == 0 {
+		terminal.ShowErrorMessage("Warning", "No Certificates Found!")
+		return
+	}
+
+	var header []string
+	rows := make([][]string, len(*c))
+
+	for index, cert := range *c {
+		models.ExtractAwsmTable(index, cert, &header, &rows)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(header)
+	table.AppendBulk(rows)
+	table.Render()
+}
+
+// getCertificateArn returns the ARN of the issued ACM Certificate in a region that covers the provided domain name, preferring
+// an exact domain match over a wildcard and the certificate that expires last
+func getCertificateArn(domain, region string) (string, error) {
+	certList := new(Certificates)
+	err := GetRegionCertificates(region, certList, "")
+	if err != nil {
+		return "", err
+	}
+
+	var exact, wildcard Certificates
+	for _, cert := range *certList {
+		if cert.Status != acm.CertificateStatusIssued || !cert.matchesDomain(domain) {
+			continue
+		}
+		if cert.Domain == domain {
+			exact = append(exact, cert)
+		} else {
+			wildcard = append(wildcard, cert)
+		}
+	}
+
+	matches := exact
+	if len(matches) == 0 {
+		matches = wildcard
+	}
+
+	if len(matches) == 0 {
+		return "", errors.New("No issued ACM Certificate found for [" + domain + "] in [" + region + "]!")
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].NotAfter.After(matches[j].NotAfter)
+	})
+
+	return matches[0].Arn, nil
+}
+
+// resolveListenerCertificates returns a copy of the listeners with certificates referenced by domain name resolved to ARNs in the region, printing them when verbose
+func resolveListenerCertificates(listeners []config.LoadBalancerListener, region string, verbose bool) ([]config.LoadBalancerListener, error) {
+	resolved := make([]config.LoadBalancerListener, len(listeners))
+	arns := make(map[string]string)
+
+	for i, listener := range listeners {
+		resolved[i] = listener
+
+		domain := listener.SSLCertificateDomain
+		if domain == "" {
+			continue
+		}
+
+		if _, ok := arns[domain]; !ok {
+			arn, err := getCertificateArn(domain, region)
+			if err != nil {
+				return resolved, err
+			}
+			if verbose {
+				terminal.Information("Found ACM Certificate [" + arn + "] for [" + domain + "] in [" + region + "]")
+			}
+			arns[domain] = arn
+		}
+
+		resolved[i].SSLCertificateID = arns[domain]
+	}
+
+	return resolved, nil
+}
+
+// WarnExpiringCertificates prints a warning for every issued ACM Certificate that expires within the warning window
+func WarnExpiringCertificates() {
+	certList, _ := GetCertificates("")
+
+	deadline := time.Now().AddDate(0, 0, certificateExpiryWarningDays)
+
+	var expiring Certificates
+	for _, cert := range *certList {
+		if cert.Status == acm.CertificateStatusIssued && cert.NotAfter.Before(deadline) {
+			expiring = append(expiring, cert)
+		}
+	}
+
+	if len(expiring) == 0 {
+		terminal.Information(fmt.Sprintf("No ACM Certificates expire in the next %d days.", certificateExpiryWarningDays))
+		return
+	}
+
+	terminal.ShowErrorMessage("Warning", fmt.Sprintf("Found [%d] ACM Certificates that expire in the next %d days!", len(expiring), certificateExpiryWarningDays))
+	expiring.PrintTable()
+}
@@ -0,0 +1,36 @@
+package aws
+
+import "testing"
+
+func TestCertificateMatchesDomain(t *testing.T) {
+	cert := Certificate{
+		Domain:           "example.com",
+		AlternativeNames: []string{"*.example.com", "www.example.org"},
+	}
+
+	tests := []struct {
+		domain string
+		want   bool
+	}{
+		{domain: "example.com", want: true},
+		{domain: "www.example.org", want: true},
+		{domain: "api.example.com", want: true},
+		{domain: "a.b.example.com", want: false},
+		{domain: "example.org", want: false},
+		{domain: "api.example.org", want: false},
+		{domain: "notexample.com", want: false},
+		{domain: "com", want: false},
+		{domain: "", want: false},
+	}
+
+	for _, test := range tests {
+		if got := cert.matchesDomain(test.domain); got != test.want {
+			t.Errorf("matchesDomain(%q) = %t, want %t", test.domain, got, test.want)
+		}
+	}
+
+	wildcard := Certificate{Domain: "*.example.com"}
+	if wildcard.matchesDomain("example.com") {
+		t.Errorf("a wildcard certificate should not match its bare domain")
+	}
+}
@@ -0,0 +1,774 @@
+package aws
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/ghodss/yaml"
+	"github.com/hashicorp/hil"
+	"github.com/hashicorp/hil/ast"
+	"github.com/murdinc/awsm/aws/regions"
+	"github.com/murdinc/awsm/config"
+)
+
+// CloudFormationTemplate represents a CloudFormation template. Resources are kept in maps, which encoding/json writes with sorted keys,
+// so the same classes always export to the same template.
+type CloudFormationTemplate struct {
+	AWSTemplateFormatVersion string
+	Description              string
+	Resources                map[string]CloudFormationResource
+}
+
+// CloudFormationResource represents a single CloudFormation resource
+type CloudFormationResource struct {
+	Type       string
+	Properties map[string]interface{}
+}
+
+// cfnExport holds the state of a template while it is being built from classes
+type cfnExport struct {
+	region         string
+	template       CloudFormationTemplate
+	securityGroups map[string]string // security group class -> logical id
+	scalingPolicy  map[string]string // scaling policy class -> logical id
+	logicalIDs     map[string]string // logical id -> class it was built from
+}
+
+// ExportCloudFormation builds a CloudFormation template for an AutoScaling Group class in a region, walking through its Launch Configuration,
+// Instance, Security Group, Load Balancer, Scaling Policy and Alarm classes. The template is returned as JSON or YAML.
+func ExportCloudFormation(class, region, format string) ([]byte, error) {
+
+	if !regions.ValidRegion(region) {
+		return nil, errors.New("Region [" + region + "] is Invalid!")
+	}
+
+	if format != "json" && format != "yaml" {
+		return nil, errors.New("Output format [" + format + "] is invalid! Must be either json or yaml")
+	}
+
+	export := &cfnExport{
+		region: region,
+		template: CloudFormationTemplate{
+			AWSTemplateFormatVersion: "2010-09-09",
+			Description:              fmt.Sprintf("awsm AutoScaling Group class [%s] in [%s]", class, region),
+			Resources:                make(map[string]CloudFormationResource),
+		},
+		securityGroups: make(map[string]string),
+		scalingPolicy:  make(map[string]string),
+		logicalIDs:     make(map[string]string),
+	}
+
+	err := export.addAutoScaleGroup(class)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := json.MarshalIndent(export.template, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if format == "yaml" {
+		return yaml.JSONToYAML(out)
+	}
+
+	return append(out, '\n'), nil
+}
+
+// cfnLogicalID builds a CloudFormation logical id from a prefix and a class name, which may contain characters that logical ids can not
+func cfnLogicalID(prefix, name string) string {
+	id := prefix
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool { return r > unicode.MaxASCII || (!unicode.IsLetter(r) && !unicode.IsDigit(r)) }) {
+		id += strings.ToUpper(part[:1]) + part[1:]
+	}
+	return id
+}
+
+// logicalID returns the logical id of a class in the template, adding a number to it when a different class already maps to the same id
+func (e *cfnExport) logicalID(prefix, class string) string {
+	base := cfnLogicalID(prefix, class)
+	id := base
+	for n := 2; ; n++ {
+		owner, ok := e.logicalIDs[id]
+		if !ok {
+			e.logicalIDs[id] = class
+			return id
+		}
+		if owner == class {
+			return id
+		}
+		id = base + strconv.Itoa(n)
+	}
+}
+
+// cfnTags returns the Name and Class tags awsm puts on the assets it creates
+func cfnTags(name, class string) []map[string]interface{} {
+	return []map[string]interface{}{
+		{"Key": "Name", "Value": name},
+		{"Key": "Class", "Value": class},
+	}
+}
+
+func cfnRef(logicalID string) map[string]interface{} {
+	return map[string]interface{}{"Ref": logicalID}
+}
+
+func cfnGroupID(logicalID string) map[string]interface{} {
+	return map[string]interface{}{"Fn::GetAtt": []string{logicalID, "GroupId"}}
+}
+
+func (e *cfnExport) addAutoScaleGroup(class string) error {
+
+	cfg, err := config.LoadAutoscalingGroupClass(class)
+	if err != nil {
+		return err
+	}
+
+	lcCfg, err := config.LoadLaunchConfigurationClass(cfg.LaunchConfigurationClass)
+	if err != nil {
+		return err
+	}
+
+	lcName := fmt.Sprintf("%s-v%d", cfg.LaunchConfigurationClass, lcCfg.Version)
+
+	props := map[string]interface{}{
+		"AutoScalingGroupName":   class,
+		"MinSize":                strconv.Itoa(cfg.MinSize),
+		"MaxSize":                strconv.Itoa(cfg.MaxSize),
+		"DesiredCapacity":        strconv.Itoa(cfg.DesiredCapacity),
+		"Cooldown":               strconv.Itoa(cfg.DefaultCooldown),
+		"HealthCheckGracePeriod": cfg.HealthCheckGracePeriod,
+		"Tags": []map[string]interface{}{
+			{"Key": "Name", "Value": lcName, "PropagateAtLaunch": true},
+			{"Key": "Class", "Value": cfg.LaunchConfigurationClass, "PropagateAtLaunch": true},
+		},
+	}
+
+	if cfg.HealthCheckType != "" {
+		props["HealthCheckType"] = cfg.HealthCheckType
+	}
+
+	if len(cfg.TerminationPolicies) > 0 {
+		props["TerminationPolicies"] = cfg.TerminationPolicies
+	}
+
+	// Availability Zones and Subnets in this region
+	regionAZs := new(regions.AZs)
+	err = regions.GetRegionAZs(e.region, regionAZs)
+	if err != nil {
+		return err
+	}
+
+	var azs []string
+	for _, az := range cfg.AvailabilityZones {
+		if regionAZs.ValidAZ(az) {
+			azs = append(azs, az)
+		}
+	}
+	if len(azs) == 0 {
+		return errors.New("AutoScaling Group class [" + class + "] has no Availability Zones in [" + e.region + "]!")
+	}
+	props["AvailabilityZones"] = azs
+
+	if cfg.SubnetClass != "" {
+		subList := new(Subnets)
+		err := GetRegionSubnets(e.region, subList, "")
+		if err != nil {
+			return err
+		}
+
+		var vpcZones []string
+		for _, az := range azs {
+			for _, sub := range *subList {
+				if sub.Class == cfg.SubnetClass && sub.AvailabilityZone == az {
+					vpcZones = append(vpcZones, sub.SubnetID)
+				}
+			}
+		}
+		props["VPCZoneIdentifier"] = vpcZones
+	}
+
+	// Launch Configuration, or a Launch Template for mixed instances
+	launchID, err := e.addLaunchConfiguration(cfg.LaunchConfigurationClass, lcCfg, len(cfg.InstanceTypeOverrides) > 0)
+	if err != nil {
+		return err
+	}
+
+	if len(cfg.InstanceTypeOverrides) > 0 {
+		overrides := make([]map[string]interface{}, len(cfg.InstanceTypeOverrides))
+		for i, instanceType := range cfg.InstanceTypeOverrides {
+			overrides[i] = map[string]interface{}{"InstanceType": instanceType}
+		}
+
+		distribution := map[string]interface{}{
+			"OnDemandBaseCapacity":                cfg.OnDemandBaseCapacity,
+			"OnDemandPercentageAboveBaseCapacity": cfg.OnDemandPercentage(),
+		}
+		if cfg.SpotAllocationStrategy != "" {
+			distribution["SpotAllocationStrategy"] = cfg.SpotAllocationStrategy
+		}
+
+		props["MixedInstancesPolicy"] = map[string]interface{}{
+			"InstancesDistribution": distribution,
+			"LaunchTemplate": map[string]interface{}{
+				"LaunchTemplateSpecification": map[string]interface{}{
+					"LaunchTemplateId": cfnRef(launchID),
+					"Version":          map[string]interface{}{"Fn::GetAtt": []string{launchID, "LatestVersionNumber"}},
+				},
+				"Overrides": overrides,
+			},
+		}
+	} else {
+		props["LaunchConfigurationName"] = cfnRef(launchID)
+	}
+
+	// Load Balancers, the ones with a class are part of the template
+	if len(cfg.LoadBalancerNames) > 0 {
+		var lbs []interface{}
+		for _, lb := range cfg.LoadBalancerNames {
+			lbCfg, err := config.LoadLoadBalancerClass(lb)
+			if err != nil {
+				lbs = append(lbs, lb)
+				continue
+			}
+
+			lbID, err := e.addLoadBalancer(lb, lbCfg)
+			if err != nil {
+				return err
+			}
+			lbs = append(lbs, cfnRef(lbID))
+		}
+		props["LoadBalancerNames"] = lbs
+	}
+
+	e.template.Resources["AutoScalingGroup"] = CloudFormationResource{
+		Type:       "AWS::AutoScaling::AutoScalingGroup",
+		Properties: props,
+	}
+
+	// Alarms and their Scaling Policies
+	for _, alarm := range cfg.Alarms {
+		err := e.addAlarm(alarm, "AutoScalingGroup")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *cfnExport) addLaunchConfiguration(class string, cfg config.LaunchConfigurationClass, launchTemplate bool) (string, error) {
+
+	instanceCfg, err := config.LoadInstanceClass(cfg.InstanceClass)
+	if err != nil {
+		return "", err
+	}
+
+	lcName := fmt.Sprintf("%s-v%d", class, cfg.Version)
+
+	// AMI
+	ami, err := GetLatestImageByTag(e.region, "Class", instanceCfg.AMI)
+	if err != nil {
+		return "", err
+	}
+
+	// EBS
+	var blockDevices []map[string]interface{}
+	for _, ebsClass := range instanceCfg.EBSVolumes {
+		volCfg, err := config.LoadVolumeClass(ebsClass)
+		if err != nil {
+			return "", err
+		}
+
+		latestSnapshot, err := GetLatestSnapshotByTag(e.region, "Class", volCfg.Snapshot)
+		if err != nil {
+			return "", err
+		}
+
+		ebs := map[string]interface{}{
+			"DeleteOnTermination": volCfg.DeleteOnTermination,
+			"SnapshotId":          latestSnapshot.SnapshotID,
+			"VolumeSize":          volCfg.VolumeSize,
+			"VolumeType":          volCfg.VolumeType,
+		}
+		if volCfg.VolumeType == "io1" {
+			ebs["Iops"] = volCfg.Iops
+		}
+
+		blockDevices = append(blockDevices, map[string]interface{}{
+			"DeviceName": volCfg.DeviceName,
+			"Ebs":        ebs,
+		})
+	}
+
+	// Security Groups
+	var vpcID string
+	if instanceCfg.Vpc != "" {
+		vpc, err := GetRegionVpcByTag(e.region, "Class", instanceCfg.Vpc)
+		if err != nil {
+			return "", err
+		}
+		vpcID = vpc.VpcID
+	}
+
+	var secGroups []interface{}
+	for _, sg := range instanceCfg.SecurityGroups {
+		sgID, err := e.addSecurityGroup(sg, vpcID)
+		if err != nil {
+			return "", err
+		}
+		secGroups = append(secGroups, cfnGroupID(sgID))
+	}
+
+	// User Data, parameters are left for CloudFormation to resolve when the stack is created
+	userData, err := cfnUserData(instanceCfg.UserData, class, cfg.Version, e.region)
+	if err != nil {
+		return "", err
+	}
+
+	// IAM Instance Profile
+	var profileArn string
+	if instanceCfg.IAMInstanceProfile != "" {
+		profile, err := GetIAMInstanceProfile(instanceCfg.IAMInstanceProfile)
+		if err != nil {
+			return "", err
+		}
+		profileArn = profile.Arn
+	}
+
+	if launchTemplate {
+		data := map[string]interface{}{
+			"ImageId":      ami.ImageID,
+			"InstanceType": instanceCfg.InstanceType,
+			"KeyName":      currentKeyPairName(instanceCfg.KeyName),
+			"EbsOptimized": instanceCfg.EbsOptimized,
+			"Monitoring":   map[string]interface{}{"Enabled": instanceCfg.Monitoring},
+			"UserData":     map[string]interface{}{"Fn::Base64": userData},
+		}
+		if len(blockDevices) > 0 {
+			data["BlockDeviceMappings"] = blockDevices
+		}
+		if profileArn != "" {
+			data["IamInstanceProfile"] = map[string]interface{}{"Arn": profileArn}
+		}
+		if instanceCfg.PublicIPAddress {
+			data["NetworkInterfaces"] = []map[string]interface{}{
+				{
+					"DeviceIndex":              0,
+					"AssociatePublicIpAddress": true,
+					"Groups":                   secGroups,
+				},
+			}
+		} else {
+			data["SecurityGroupIds"] = secGroups
+		}
+
+		e.template.Resources["LaunchTemplate"] = CloudFormationResource{
+			Type: "AWS::EC2::LaunchTemplate",
+			Properties: map[string]interface{}{
+				"LaunchTemplateName": lcName,
+				"LaunchTemplateData": data,
+			},
+		}
+		return "LaunchTemplate", nil
+	}
+
+	props := map[string]interface{}{
+		"LaunchConfigurationName":  lcName,
+		"ImageId":                  ami.ImageID,
+		"InstanceType":             instanceCfg.InstanceType,
+		"KeyName":                  currentKeyPairName(instanceCfg.KeyName),
+		"AssociatePublicIpAddress": instanceCfg.PublicIPAddress,
+		"InstanceMonitoring":       instanceCfg.Monitoring,
+		"EbsOptimized":             instanceCfg.EbsOptimized,
+		"SecurityGroups":           secGroups,
+		"UserData":                 map[string]interface{}{"Fn::Base64": userData},
+	}
+	if len(blockDevices) > 0 {
+		props["BlockDeviceMappings"] = blockDevices
+	}
+	if profileArn != "" {
+		props["IamInstanceProfile"] = profileArn
+	}
+
+	e.template.Resources["LaunchConfiguration"] = CloudFormationResource{
+		Type:       "AWS::AutoScaling::LaunchConfiguration",
+		Properties: props,
+	}
+	return "LaunchConfiguration", nil
+}
+
+// cfnUserData evaluates the user data of an instance class like a Launch Configuration would, except that ${ssm("/path")} becomes a
+// CloudFormation dynamic reference so that parameter values never end up in the template. CloudFormation only resolves String parameters there.
+func cfnUserData(userData, class string, sequence int, region string) (string, error) {
+	tree, err := hil.Parse(userData)
+	if err != nil {
+		return "", err
+	}
+
+	evalConfig := &hil.EvalConfig{
+		GlobalScope: &ast.BasicScope{
+			VarMap: map[string]ast.Variable{
+				"var.class": ast.Variable{
+					Type:  ast.TypeString,
+					Value: class,
+				},
+				"var.sequence": ast.Variable{
+					Type:  ast.TypeInt,
+					Value: sequence,
+				},
+				"var.locale": ast.Variable{
+					Type:  ast.TypeString,
+					Value: region,
+				},
+			},
+			FuncMap: map[string]ast.Function{
+				"ssm": {
+					ArgTypes:   []ast.Type{ast.TypeString},
+					ReturnType: ast.TypeString,
+					Callback: func(args []interface{}) (interface{}, error) {
+						return "{{resolve:ssm:" + args[0].(string) + "}}", nil
+					},
+				},
+			},
+		},
+	}
+
+	result, err := hil.Eval(tree, evalConfig)
+	if err != nil {
+		return "", err
+	}
+
+	return result.Value.(string), nil
+}
+
+func (e *cfnExport) addSecurityGroup(class, vpcID string) (string, error) {
+
+	if id, ok := e.securityGroups[class]; ok {
+		return id, nil
+	}
+
+	cfg, err := config.LoadSecurityGroupClass(class, false)
+	if err != nil {
+		return "", err
+	}
+
+	id := e.logicalID("SecurityGroup", class)
+	e.securityGroups[class] = id
+
+	props := map[string]interface{}{
+		"GroupName":        class,
+		"GroupDescription": cfg.Description,
+		"Tags":             cfnTags(class, class),
+	}
+	if vpcID != "" {
+		props["VpcId"] = vpcID
+	}
+
+	var ingress, egress []map[string]interface{}
+	var groupGrants []config.SecurityGroupGrant
+
+	for _, grant := range cfg.SecurityGroupGrants {
+		rule := map[string]interface{}{
+			"IpProtocol": grant.IPProtocol,
+			"FromPort":   grant.FromPort,
+			"ToPort":     grant.ToPort,
+		}
+		if grant.Note != "" {
+			rule["Description"] = grant.Note
+		}
+
+		var rules []map[string]interface{}
+		for _, cidr := range grant.CidrIPs {
+			rules = append(rules, cfnRule(rule, "CidrIp", cidr))
+		}
+		for _, cidr := range grant.CidrIPv6s {
+			rules = append(rules, cfnRule(rule, "CidrIpv6", cidr))
+		}
+		for _, prefixList := range grant.PrefixListIDs {
+			if grant.Type == "egress" {
+				rules = append(rules, cfnRule(rule, "DestinationPrefixListId", prefixList))
+			} else {
+				rules = append(rules, cfnRule(rule, "SourcePrefixListId", prefixList))
+			}
+		}
+
+		if grant.Type == "egress" {
+			egress = append(egress, rules...)
+		} else {
+			ingress = append(ingress, rules...)
+		}
+
+		// Group to group grants become their own resources, so that groups can reference each other (or themselves) without a cycle
+		if len(grant.SourceSecurityGroupNames) > 0 {
+			groupGrants = append(groupGrants, grant)
+		}
+	}
+
+	if len(ingress) > 0 {
+		props["SecurityGroupIngress"] = ingress
+	}
+	if len(egress) > 0 {
+		props["SecurityGroupEgress"] = egress
+	}
+
+	e.template.Resources[id] = CloudFormationResource{
+		Type:       "AWS::EC2::SecurityGroup",
+		Properties: props,
+	}
+
+	n := 0
+	for _, grant := range groupGrants {
+		for _, source := range grant.SourceSecurityGroupNames {
+			var sourceID interface{}
+			if _, err := config.LoadSecurityGroupClass(source, false); err == nil {
+				sourceLogicalID, err := e.addSecurityGroup(source, vpcID)
+				if err != nil {
+					return "", err
+				}
+				sourceID = cfnGroupID(sourceLogicalID)
+			} else if vpcID != "" {
+				vpc := Vpc{VpcID: vpcID, Region: e.region}
+				sg, err := vpc.GetVpcSecurityGroupByTag("Name", source)
+				if err != nil {
+					return "", err
+				}
+				sourceID = sg.GroupID
+			}
+
+			n++
+			rule := map[string]interface{}{
+				"GroupId":    cfnGroupID(id),
+				"IpProtocol": grant.IPProtocol,
+				"FromPort":   grant.FromPort,
+				"ToPort":     grant.ToPort,
+			}
+			if grant.Note != "" {
+				rule["Description"] = grant.Note
+			}
+
+			if grant.Type == "egress" {
+				// Egress rules can only name a group by its id, there is no name to fall back to
+				if sourceID == nil {
+					return "", errors.New("Unable to resolve the destination Security Group [" + source + "] of an egress grant of Security Group class [" + class + "]!")
+				}
+				rule["DestinationSecurityGroupId"] = sourceID
+				e.template.Resources[fmt.Sprintf("%sEgress%d", id, n)] = CloudFormationResource{
+					Type:       "AWS::EC2::SecurityGroupEgress",
+					Properties: rule,
+				}
+			} else {
+				if sourceID != nil {
+					rule["SourceSecurityGroupId"] = sourceID
+				} else {
+					rule["SourceSecurityGroupName"] = source
+				}
+				e.template.Resources[fmt.Sprintf("%sIngress%d", id, n)] = CloudFormationResource{
+					Type:       "AWS::EC2::SecurityGroupIngress",
+					Properties: rule,
+				}
+			}
+		}
+	}
+
+	return id, nil
+}
+
+// cfnRule returns a copy of a security group rule with one more property set
+func cfnRule(rule map[string]interface{}, key, value string) map[string]interface{} {
+	newRule := make(map[string]interface{}, len(rule)+1)
+	for k, v := range rule {
+		newRule[k] = v
+	}
+	newRule[key] = value
+	return newRule
+}
+
+func (e *cfnExport) addLoadBalancer(class string, cfg config.LoadBalancerClass) (string, error) {
+
+	id := e.logicalID("LoadBalancer", class)
+	if _, ok := e.template.Resources[id]; ok {
+		return id, nil
+	}
+
+	props := map[string]interface{}{
+		"LoadBalancerName": class,
+		"Tags":             cfnTags(class, class),
+		"CrossZone":        cfg.LoadBalancerAttributes.CrossZoneLoadBalancingEnabled,
+		"ConnectionSettings": map[string]interface{}{
+			"IdleTimeout": cfg.LoadBalancerAttributes.IdleTimeout,
+		},
+		"ConnectionDrainingPolicy": map[string]interface{}{
+			"Enabled": cfg.LoadBalancerAttributes.ConnectionDrainingEnabled,
+			"Timeout": cfg.LoadBalancerAttributes.ConnectionDrainingTimeout,
+		},
+		"HealthCheck": map[string]interface{}{
+			"Target":             cfg.LoadBalancerHealthCheck.HealthCheckTarget,
+			"Timeout":            strconv.Itoa(cfg.LoadBalancerHealthCheck.HealthCheckTimeout),
+			"Interval":           strconv.Itoa(cfg.LoadBalancerHealthCheck.HealthCheckInterval),
+			"UnhealthyThreshold": strconv.Itoa(cfg.LoadBalancerHealthCheck.HealthCheckUnhealthyThreshold),
+			"HealthyThreshold":   strconv.Itoa(cfg.LoadBalancerHealthCheck.HealthCheckHealthyThreshold),
+		},
+	}
+
+	if cfg.Scheme != "" {
+		props["Scheme"] = cfg.Scheme
+	}
+
+	if cfg.LoadBalancerAttributes.AccessLogEnabled {
+		props["AccessLoggingPolicy"] = map[string]interface{}{
+			"Enabled":        true,
+			"EmitInterval":   cfg.LoadBalancerAttributes.AccessLogEmitInterval,
+			"S3BucketName":   cfg.LoadBalancerAttributes.AccessLogS3BucketName,
+			"S3BucketPrefix": cfg.LoadBalancerAttributes.AccessLogS3BucketPrefix,
+		}
+	}
+
+	// Subnets and Security Groups, or Availability Zones
+	if cfg.Vpc != "" {
+		vpc, err := GetRegionVpcByTag(e.region, "Class", cfg.Vpc)
+		if err != nil {
+			return "", err
+		}
+
+		var subnets []string
+		for _, sn := range cfg.Subnets {
+			subnet, err := vpc.GetVpcSubnetByTag("Class", sn)
+			if err != nil {
+				return "", err
+			}
+			subnets = append(subnets, subnet.SubnetID)
+		}
+		props["Subnets"] = subnets
+
+		var secGroups []interface{}
+		for _, sg := range cfg.SecurityGroups {
+			sgID, err := e.addSecurityGroup(sg, vpc.VpcID)
+			if err != nil {
+				return "", err
+			}
+			secGroups = append(secGroups, cfnGroupID(sgID))
+		}
+		if len(secGroups) > 0 {
+			props["SecurityGroups"] = secGroups
+		}
+	} else {
+		regionAZs := new(regions.AZs)
+		regions.GetRegionAZs(e.region, regionAZs)
+
+		var azs []string
+		for _, az := range cfg.AvailabilityZones {
+			if regionAZs.ValidAZ(az) {
+				azs = append(azs, az)
+			}
+		}
+		props["AvailabilityZones"] = azs
+	}
+
+	// Listeners
+	listeners := make([]map[string]interface{}, len(cfg.LoadBalancerListeners))
+	for i, l := range cfg.LoadBalancerListeners {
+		listener := map[string]interface{}{
+			"LoadBalancerPort": strconv.Itoa(l.LoadBalancerPort),
+			"InstancePort":     strconv.Itoa(l.InstancePort),
+			"Protocol":         l.Protocol,
+			"InstanceProtocol": l.InstanceProtocol,
+		}
+
+		certID := l.SSLCertificateID
+		if l.SSLCertificateDomain != "" {
+			arn, err := getCertificateArn(l.SSLCertificateDomain, e.region)
+			if err != nil {
+				return "", err
+			}
+			certID = arn
+		}
+		if certID != "" {
+			listener["SSLCertificateId"] = certID
+		}
+
+		listeners[i] = listener
+	}
+	props["Listeners"] = listeners
+
+	e.template.Resources[id] = CloudFormationResource{
+		Type:       "AWS::ElasticLoadBalancing::LoadBalancer",
+		Properties: props,
+	}
+
+	return id, nil
+}
+
+func (e *cfnExport) addAlarm(class, asgID string) error {
+
+	cfg, err := config.LoadAlarmClass(class)
+	if err != nil {
+		return err
+	}
+
+	props := map[string]interface{}{
+		"AlarmName":          class,
+		"AlarmDescription":   cfg.AlarmDescription,
+		"ComparisonOperator": cfg.ComparisonOperator,
+		"EvaluationPeriods":  cfg.EvaluationPeriods,
+		"MetricName":         cfg.MetricName,
+		"Namespace":          cfg.Namespace,
+		"Period":             cfg.Period,
+		"Statistic":          cfg.Statistic,
+		"Threshold":          cfg.Threshold,
+		"ActionsEnabled":     cfg.ActionsEnabled,
+		"Dimensions": []map[string]interface{}{
+			{"Name": "AutoScalingGroupName", "Value": cfnRef(asgID)},
+		},
+	}
+
+	if cfg.Unit != "" {
+		props["Unit"] = cfg.Unit
+	}
+
+	// Alarm actions that are Scaling Policy classes become Scaling Policies on the group, like createAutoScaleAlarms does
+	var actions []interface{}
+	for _, action := range cfg.AlarmActions {
+		policyCfg, err := config.LoadScalingPolicyClass(action)
+		if err != nil {
+			continue
+		}
+
+		policyID, ok := e.scalingPolicy[action]
+		if !ok {
+			policyID = e.logicalID("ScalingPolicy", action)
+			e.scalingPolicy[action] = policyID
+
+			e.template.Resources[policyID] = CloudFormationResource{
+				Type: "AWS::AutoScaling::ScalingPolicy",
+				Properties: map[string]interface{}{
+					"AdjustmentType":       policyCfg.AdjustmentType,
+					"AutoScalingGroupName": cfnRef(asgID),
+					"ScalingAdjustment":    policyCfg.ScalingAdjustment,
+					"Cooldown":             strconv.Itoa(policyCfg.Cooldown),
+				},
+			}
+		}
+		actions = append(actions, cfnRef(policyID))
+	}
+	if len(actions) > 0 {
+		props["AlarmActions"] = actions
+	}
+	if len(cfg.OKActions) > 0 {
+		props["OKActions"] = cfg.OKActions
+	}
+	if len(cfg.InsufficientDataActions) > 0 {
+		props["InsufficientDataActions"] = cfg.InsufficientDataActions
+	}
+
+	e.template.Resources[e.logicalID("Alarm", class)] = CloudFormationResource{
+		Type:       "AWS::CloudWatch::Alarm",
+		Properties: props,
+	}
+
+	return nil
+}
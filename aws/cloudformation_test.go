@@ -0,0 +1,47 @@
+package aws
+
+import "testing"
+
+func TestCfnLogicalID(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "web", want: "SecurityGroupWeb"},
+		{name: "web-app", want: "SecurityGroupWebApp"},
+		{name: "webApp", want: "SecurityGroupWebApp"},
+		{name: "web_app v2", want: "SecurityGroupWebAppV2"},
+		{name: "123", want: "SecurityGroup123"},
+		{name: "héllo", want: "SecurityGroupHLlo"},
+		{name: "ünïcode", want: "SecurityGroupNCode"},
+		{name: "", want: "SecurityGroup"},
+	}
+
+	for _, test := range tests {
+		if got := cfnLogicalID("SecurityGroup", test.name); got != test.want {
+			t.Errorf("cfnLogicalID(%q) = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func TestCfnExportLogicalIDCollisions(t *testing.T) {
+	e := &cfnExport{logicalIDs: make(map[string]string)}
+
+	tests := []struct {
+		class string
+		want  string
+	}{
+		{class: "web-app", want: "SecurityGroupWebApp"},
+		{class: "webApp", want: "SecurityGroupWebApp2"},
+		{class: "web-app", want: "SecurityGroupWebApp"},
+		{class: "web app", want: "SecurityGroupWebApp3"},
+		{class: "web-app2", want: "SecurityGroupWebApp22"},
+		{class: "webApp", want: "SecurityGroupWebApp2"},
+	}
+
+	for _, test := range tests {
+		if got := e.logicalID("SecurityGroup", test.class); got != test.want {
+			t.Errorf("logicalID(%q) = %q, want %q", test.class, got, test.want)
+		}
+	}
+}
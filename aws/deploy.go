@@ -0,0 +1,507 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/murdinc/awsm/aws/regions"
+	"github.com/murdinc/awsm/config"
+	"github.com/murdinc/terminal"
+)
+
+// deployFailureBudget is how many failed launches, or health checks in a row that come up short or can not be made, a deployment
+// tolerates before it is rolled back
+const deployFailureBudget = 3
+
+// deployment is a single blue/green replacement of the AutoScale Groups of a class in one region
+type deployment struct {
+	region    string
+	regionAZs []string
+	name      string
+	desired   int
+	old       AutoScaleGroups
+}
+
+// Deploy replaces the AutoScale Groups of a class with new groups running the given Launch Configuration version. Each new group is
+// created alongside the old ones and must have all of its instances InService on the Load Balancers of the class before the old groups
+// are scaled down, and the old groups are only deleted once the new group stayed healthy for the bake period. A failed health check
+// at any point rolls the region back to the old groups.
+func Deploy(class, version, bake, timeout string, dryRun bool) error {
+
+	// --dry-run flag
+	if dryRun {
+		terminal.Information("--dry-run flag is set, not making any actual changes!")
+	}
+
+	bakePeriod, err := time.ParseDuration(bake)
+	if err != nil {
+		return errors.New("Bake period [" + bake + "] is invalid, use a duration like 10m or 1h!")
+	}
+
+	healthTimeout, err := time.ParseDuration(timeout)
+	if err != nil {
+		return errors.New("Timeout [" + timeout + "] is invalid, use a duration like 15m or 1h!")
+	}
+
+	// Verify the asg config class input
+	cfg, err := config.LoadAutoscalingGroupClass(class)
+	if err != nil {
+		return err
+	}
+	terminal.Information("Found Autoscaling group class configuration for [" + class + "]")
+
+	// Verify the launchconfig class input
+	launchConfigurationCfg, err := config.LoadLaunchConfigurationClass(cfg.LaunchConfigurationClass)
+	if err != nil {
+		return err
+	}
+	terminal.Information("Found Launch Configuration class configuration for [" + cfg.LaunchConfigurationClass + "]")
+
+	// Set passed version early
+	if version != "" {
+		lcVer, err := strconv.Atoi(version)
+		if err != nil {
+			return err
+		}
+		launchConfigurationCfg.Version = lcVer
+		terminal.Information(fmt.Sprintf("Using Launch Configuration version [%d] passed in as an argument.", launchConfigurationCfg.Version))
+	}
+
+	if launchConfigurationCfg.Version < 1 {
+		return errors.New("Launch Configuration class [" + cfg.LaunchConfigurationClass + "] has not been built yet!")
+	}
+
+	// Get the AZs
+	azs, errs := regions.GetAZs()
+	if errs != nil {
+		return errors.New("Error gathering region list")
+	}
+
+	regionMap := azs.GetRegionMap(cfg.AvailabilityZones)
+	var regionList []string
+	for region := range regionMap {
+		regionList = append(regionList, region)
+	}
+	sort.Strings(regionList)
+
+	name := fmt.Sprintf("%s-v%d", class, launchConfigurationCfg.Version)
+	var deployments []deployment
+	oldList := new(AutoScaleGroups)
+
+	for _, region := range regionList {
+
+		// Verify that the Launch Configuration version is available in this region
+		lcName := GetLaunchConfigurationName(region, cfg.LaunchConfigurationClass, launchConfigurationCfg.Version)
+		if lcName == "" {
+			return fmt.Errorf("Launch Configuration [%s] version [%d] is not available in [%s]!", cfg.LaunchConfigurationClass, launchConfigurationCfg.Version, region)
+		}
+		terminal.Information(fmt.Sprintf("Found Launch Configuration [%s] version [%d] in [%s]", cfg.LaunchConfigurationClass, launchConfigurationCfg.Version, region))
+
+		asgList := new(AutoScaleGroups)
+		err := GetRegionAutoScaleGroups(region, asgList, "")
+		if err != nil {
+			return err
+		}
+
+		d := deployment{
+			region:    region,
+			regionAZs: regionMap[region],
+			name:      name,
+		}
+
+		// The current groups of the class are named after it, with an optional version suffix from an earlier deploy
+		for _, asg := range *asgList {
+			if asg.Name == name {
+				return errors.New("AutoScaling Group [" + name + "] already exists in [" + region + "], Aborting!")
+			}
+			if isClassAutoScaleGroup(asg.Name, class) {
+				d.old = append(d.old, asg)
+				d.desired += asg.DesiredCapacity
+			}
+		}
+
+		// Old groups that are scaled down to nothing would leave the new group empty, fall back to the class
+		if d.desired == 0 {
+			d.desired = cfg.DesiredCapacity
+		}
+		if d.desired < cfg.MinSize {
+			d.desired = cfg.MinSize
+		}
+		if d.desired == 0 {
+			return errors.New("AutoScaling Group class [" + class + "] would deploy an empty group in [" + region + "], set its Desired Capacity or Min Size, Aborting!")
+		}
+
+		*oldList = append(*oldList, d.old...)
+		deployments = append(deployments, d)
+	}
+
+	if len(deployments) == 0 {
+		return errors.New("AutoScaling Group class [" + class + "] has no Availability Zones, Aborting!")
+	}
+
+	// Print the plan
+	if len(*oldList) > 0 {
+		oldList.PrintTable()
+	}
+
+	for _, d := range deployments {
+		var oldNames []string
+		for _, asg := range d.old {
+			oldNames = append(oldNames, asg.Name)
+		}
+
+		terminal.Notice(fmt.Sprintf("[%s] - Create [%s] with [%d] instances, then scale down and delete [%s] after [%s]", d.region, d.name, d.desired, strings.Join(oldNames, ", "), bakePeriod))
+	}
+
+	if dryRun {
+		return nil
+	}
+
+	// Confirm
+	if !terminal.PromptBool("Are you sure you want to deploy version [" + strconv.Itoa(launchConfigurationCfg.Version) + "] of [" + class + "]?") {
+		return errors.New("Aborting!")
+	}
+
+	for _, d := range deployments {
+		err := d.run(cfg, launchConfigurationCfg, azs, bakePeriod, healthTimeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	terminal.Information("Done!")
+
+	return nil
+}
+
+// run does the deployment in a single region, rolling back to the old groups if the new group fails its health checks
+func (d deployment) run(cfg config.AutoscaleGroupClass, launchConfigurationCfg config.LaunchConfigurationClass, azs *regions.AZs, bakePeriod, healthTimeout time.Duration) error {
+
+	newList := &AutoScaleGroups{AutoScaleGroup{Name: d.name, Region: d.region}}
+
+	// Create the new group next to the old ones, at their combined size
+	newCfg := cfg
+	newCfg.DesiredCapacity = d.desired
+	if newCfg.MaxSize < d.desired {
+		newCfg.MaxSize = d.desired
+	}
+	if newCfg.MinSize > d.desired {
+		newCfg.MinSize = d.desired
+	}
+
+	err := createAutoScaleGroup(d.name, newCfg, launchConfigurationCfg, d.region, d.regionAZs, azs, false)
+	if err != nil {
+		return err
+	}
+
+	terminal.Notice("Waiting for the instances of [" + d.name + "] to be InService...")
+
+	err = waitForHealthyAutoScaleGroup(d.name, d.region, cfg.LoadBalancerNames, d.desired, healthTimeout, true)
+	if err != nil {
+		terminal.ShowErrorMessage("Health checks failed, rolling back!", err.Error())
+
+		rollbackErr := deleteAutoScaleGroups(newList, true, false)
+		if rollbackErr != nil {
+			return fmt.Errorf("Deployment of [%s] in [%s] failed [%s] and the new group could not be deleted: %s", d.name, d.region, err, rollbackErr)
+		}
+
+		return fmt.Errorf("Deployment of [%s] in [%s] was rolled back: %s", d.name, d.region, err)
+	}
+
+	// Move the alarms and scaling policies to the new group, and scale down the old ones
+	err = createAutoScaleGroupAlarms(cfg.Alarms, newList)
+	if err != nil {
+		return err
+	}
+
+	for _, asg := range d.old {
+		err := scaleAutoScaleGroup(asg.Name, asg.Region, 0, 0, asg.MaxSize)
+		if err != nil {
+			return err
+		}
+		terminal.Delta("Scaled down AutoScaling Group [" + asg.Name + "] in [" + asg.Region + "]")
+	}
+
+	// Bake
+	terminal.Notice(fmt.Sprintf("Baking [%s] for [%s]...", d.name, bakePeriod))
+
+	err = bakeAutoScaleGroup(d.name, d.region, cfg.LoadBalancerNames, d.desired, bakePeriod)
+	if err != nil {
+		terminal.ShowErrorMessage("Health checks failed while baking, rolling back!", err.Error())
+
+		rollbackErr := d.rollback(cfg, newList, healthTimeout)
+		if rollbackErr != nil {
+			return fmt.Errorf("Deployment of [%s] in [%s] failed [%s] and could not be rolled back: %s", d.name, d.region, err, rollbackErr)
+		}
+
+		return fmt.Errorf("Deployment of [%s] in [%s] was rolled back: %s", d.name, d.region, err)
+	}
+
+	// Delete the old groups
+	if len(d.old) > 0 {
+		err = deleteAutoScaleGroups(&d.old, true, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	terminal.Delta("Deployed [" + d.name + "] in [" + d.region + "]!")
+
+	return nil
+}
+
+// rollback scales the old groups back up and waits for them to be healthy before moving the alarms back and deleting the new group
+func (d deployment) rollback(cfg config.AutoscaleGroupClass, newList *AutoScaleGroups, healthTimeout time.Duration) error {
+
+	for _, asg := range d.old {
+		err := scaleAutoScaleGroup(asg.Name, asg.Region, asg.MinSize, asg.DesiredCapacity, asg.MaxSize)
+		if err != nil {
+			return err
+		}
+		terminal.Delta("Scaled AutoScaling Group [" + asg.Name + "] in [" + asg.Region + "] back up")
+	}
+
+	for _, asg := range d.old {
+		// The old groups replace their own Unhealthy instances, and only launches from now on count
+		err := waitForHealthyAutoScaleGroup(asg.Name, asg.Region, cfg.LoadBalancerNames, asg.DesiredCapacity, healthTimeout, false)
+		if err != nil {
+			return errors.New("The old AutoScaling Group [" + asg.Name + "] did not become healthy, leaving the new group [" + d.name + "] in place: " + err.Error())
+		}
+	}
+
+	if len(d.old) > 0 {
+		err := createAutoScaleGroupAlarms(cfg.Alarms, &d.old)
+		if err != nil {
+			return err
+		}
+	}
+
+	return deleteAutoScaleGroups(newList, true, false)
+}
+
+// createAutoScaleGroupAlarms creates or updates the alarms of a class, along with their scaling policies, for the given AutoScale Groups
+func createAutoScaleGroupAlarms(alarms []string, asgList *AutoScaleGroups) error {
+
+	for _, alarm := range alarms {
+		alarmCfg, err := config.LoadAlarmClass(alarm)
+		if err != nil {
+			return err
+		}
+
+		err = createAutoScaleAlarms(alarm, alarmCfg, asgList, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scaleAutoScaleGroup sets the min, desired and max sizes of an AutoScale Group
+func scaleAutoScaleGroup(name, region string, min, desired, max int) error {
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := autoscaling.New(sess)
+
+	_, err := svc.UpdateAutoScalingGroup(&autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(name),
+		MinSize:              aws.Int64(int64(min)),
+		DesiredCapacity:      aws.Int64(int64(desired)),
+		MaxSize:              aws.Int64(int64(max)),
+	})
+
+	return awsErrorMessage(err)
+}
+
+// groupHealth is the result of a single health check of an AutoScale Group
+type groupHealth struct {
+	healthy        int // InService in the group and on every Load Balancer
+	unhealthy      int // marked Unhealthy, the group replaces these on its own
+	failedLaunches int // failed scaling activities since the check started
+}
+
+// waitForHealthyAutoScaleGroup waits until the desired number of instances of an AutoScale Group are InService on all of its Load Balancers.
+// Failed launches and retryable API errors count toward a failure budget, and with strict set an instance marked Unhealthy fails right away.
+func waitForHealthyAutoScaleGroup(name, region string, loadBalancers []string, desired int, timeout time.Duration, strict bool) error {
+
+	since := time.Now()
+	deadline := since.Add(timeout)
+	apiFailures := 0
+	var last groupHealth // the last health that could be checked, for reporting
+
+	for {
+		health, err := autoScaleGroupHealth(name, region, loadBalancers, since)
+		if err != nil {
+			if !retryableError(err) {
+				return awsErrorMessage(err)
+			}
+
+			apiFailures++
+			if apiFailures >= deployFailureBudget {
+				return fmt.Errorf("Unable to check the health of [%s] after %d tries: %s", name, apiFailures, awsErrorMessage(err))
+			}
+		} else {
+			apiFailures = 0
+			last = health
+
+			if strict && health.unhealthy > 0 {
+				return fmt.Errorf("%d instances of [%s] were marked Unhealthy", health.unhealthy, name)
+			}
+
+			if health.failedLaunches >= deployFailureBudget {
+				return fmt.Errorf("%d instance launches of [%s] failed", health.failedLaunches, name)
+			}
+
+			if health.healthy >= desired {
+				terminal.Information(fmt.Sprintf("All %d instances of [%s] are InService!", desired, name))
+				return nil
+			}
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("Only %d of %d instances of [%s] were InService after %s", last.healthy, desired, name, timeout)
+		}
+
+		terminal.Notice(fmt.Sprintf("%d of %d instances of [%s] are InService, checking again in 15s...", last.healthy, desired, name))
+		time.Sleep(15 * time.Second)
+	}
+}
+
+// bakeAutoScaleGroup keeps checking the health of an AutoScale Group for the bake period, a check that comes up short or can not be made
+// counts toward the failure budget, and the budget is reset by the next healthy check
+func bakeAutoScaleGroup(name, region string, loadBalancers []string, desired int, bakePeriod time.Duration) error {
+
+	since := time.Now()
+	end := since.Add(bakePeriod)
+	failures := 0
+
+	for time.Now().Before(end) {
+		time.Sleep(30 * time.Second)
+
+		health, err := autoScaleGroupHealth(name, region, loadBalancers, since)
+		if err != nil && !retryableError(err) {
+			return awsErrorMessage(err)
+		}
+
+		if err == nil && health.failedLaunches >= deployFailureBudget {
+			return fmt.Errorf("%d instance launches of [%s] failed while baking", health.failedLaunches, name)
+		}
+
+		if err != nil || health.unhealthy > 0 || health.healthy < desired {
+			failures++
+			if err != nil {
+				terminal.Notice("Unable to check the health of [" + name + "]: " + awsErrorMessage(err).Error())
+			} else {
+				terminal.Notice(fmt.Sprintf("Only %d of %d instances of [%s] are InService", health.healthy, desired, name))
+			}
+
+			if failures >= deployFailureBudget {
+				return fmt.Errorf("[%s] failed %d health checks in a row", name, failures)
+			}
+			continue
+		}
+
+		failures = 0
+	}
+
+	return nil
+}
+
+// retryableError returns true for API errors that are worth trying again, like throttling and service errors
+func retryableError(err error) bool {
+	return request.IsErrorRetryable(err) || request.IsErrorThrottle(err)
+}
+
+// awsErrorMessage converts an AWS error into a plain error with just its message
+func awsErrorMessage(err error) error {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return errors.New(awsErr.Message())
+	}
+	return err
+}
+
+// autoScaleGroupHealth checks how many instances of an AutoScale Group are InService both in the group and on every one of the given
+// Load Balancers, along with how many were marked Unhealthy and how many launches failed since the given time
+func autoScaleGroupHealth(name, region string, loadBalancers []string, since time.Time) (groupHealth, error) {
+
+	var health groupHealth
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	asgSvc := autoscaling.New(sess)
+	elbSvc := elb.New(sess)
+
+	resp, err := asgSvc.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []*string{aws.String(name)},
+	})
+	if err != nil {
+		return health, err
+	}
+	if len(resp.AutoScalingGroups) == 0 {
+		return health, errors.New("AutoScaling Group [" + name + "] was not found in [" + region + "]!")
+	}
+
+	// Failed launches, older activities belong to earlier deployments
+	activities, err := asgSvc.DescribeScalingActivities(&autoscaling.DescribeScalingActivitiesInput{
+		AutoScalingGroupName: aws.String(name),
+	})
+	if err != nil {
+		return health, err
+	}
+	for _, activity := range activities.Activities {
+		if aws.StringValue(activity.StatusCode) == "Failed" && aws.TimeValue(activity.StartTime).After(since) {
+			health.failedLaunches++
+		}
+	}
+
+	var instances []*elb.Instance
+	inService := make(map[string]int)
+	for _, instance := range resp.AutoScalingGroups[0].Instances {
+		if aws.StringValue(instance.HealthStatus) == "Unhealthy" {
+			health.unhealthy++
+			continue
+		}
+
+		if aws.StringValue(instance.LifecycleState) == "InService" {
+			instances = append(instances, &elb.Instance{InstanceId: instance.InstanceId})
+			inService[aws.StringValue(instance.InstanceId)] = 0
+		}
+	}
+
+	if len(instances) == 0 {
+		return health, nil
+	}
+
+	for _, lb := range loadBalancers {
+		lbHealth, err := elbSvc.DescribeInstanceHealth(&elb.DescribeInstanceHealthInput{
+			LoadBalancerName: aws.String(lb),
+			Instances:        instances,
+		})
+		if err != nil {
+			return health, err
+		}
+
+		for _, state := range lbHealth.InstanceStates {
+			if aws.StringValue(state.State) == "InService" {
+				inService[aws.StringValue(state.InstanceId)]++
+			}
+		}
+	}
+
+	for _, count := range inService {
+		if count == len(loadBalancers) {
+			health.healthy++
+		}
+	}
+
+	return health, nil
+}
@@ -0,0 +1,640 @@
+package aws
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/murdinc/awsm/aws/regions"
+	"github.com/murdinc/awsm/config"
+	"github.com/murdinc/awsm/models"
+	"github.com/murdinc/terminal"
+	"github.com/olekukonko/tablewriter"
+)
+
+// driftAssetTypes are the asset types that can be checked for drift
+var driftAssetTypes = []string{"autoscalegroups", "instances", "volumes", "vpcs", "subnets", "securitygroups", "loadbalancers"}
+
+// Drifts represents a slice of differences between class-managed assets and their classes
+type Drifts []Drift
+
+// Drift represents a single difference between a class-managed asset and its class
+type Drift models.Drift
+
+// PrintDrift compares class-tagged assets with their classes and prints the differences as a table or json, returning true if any were found
+func PrintDrift(assetType, region, output string) (bool, error) {
+
+	drifts, err := GetDrift(assetType, region)
+	if err != nil {
+		return false, err
+	}
+
+	switch output {
+	case "json":
+		out, err := json.MarshalIndent(drifts, "", "  ")
+		if err != nil {
+			return false, err
+		}
+		fmt.Println(string(out))
+
+	default:
+		if len(drifts) == 0 {
+			terminal.Information("No drift found, all class-managed assets match their classes!")
+		} else {
+			drifts.PrintTable()
+		}
+	}
+
+	return len(drifts) > 0, nil
+}
+
+// GetDrift compares class-tagged assets of one or all asset types, in one or all regions, with their classes
+func GetDrift(assetType, region string) (Drifts, error) {
+	drifts := Drifts{}
+
+	if region != "" && !regions.ValidRegion(region) {
+		return drifts, errors.New("Region [" + region + "] is Invalid!")
+	}
+
+	assetTypes := driftAssetTypes
+	if assetType != "" {
+		found := false
+		for _, t := range driftAssetTypes {
+			if t == assetType {
+				found = true
+			}
+		}
+		if !found {
+			return drifts, errors.New("Asset type [" + assetType + "] is invalid! Must be one of: " + strings.Join(driftAssetTypes, ", "))
+		}
+		assetTypes = []string{assetType}
+	}
+
+	for _, t := range assetTypes {
+		var err error
+
+		switch t {
+		case "autoscalegroups":
+			err = drifts.autoScaleGroups(region)
+		case "instances":
+			err = drifts.instances(region)
+		case "volumes":
+			err = drifts.volumes(region)
+		case "vpcs":
+			err = drifts.vpcs(region)
+		case "subnets":
+			err = drifts.subnets(region)
+		case "securitygroups":
+			err = drifts.securityGroups(region)
+		case "loadbalancers":
+			err = drifts.loadBalancers(region)
+		}
+
+		if err != nil {
+			return drifts, err
+		}
+	}
+
+	sort.SliceStable(drifts, func(i, j int) bool {
+		if drifts[i].AssetType != drifts[j].AssetType {
+			return drifts[i].AssetType < drifts[j].AssetType
+		}
+		if drifts[i].Region != drifts[j].Region {
+			return drifts[i].Region < drifts[j].Region
+		}
+		return drifts[i].Name < drifts[j].Name
+	})
+
+	return drifts, nil
+}
+
+// PrintTable Prints an ascii table of the list of Drifts
+func (d *Drifts) PrintTable() {
+	if len(*d) == 0 {
+		terminal.ShowErrorMessage("Warning", "No Drift Found!")
+		return
+	}
+
+	var header []string
+	rows := make([][]string, len(*d))
+
+	for index, drift := range *d {
+		models.ExtractAwsmTable(index, drift, &header, &rows)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(header)
+	table.AppendBulk(rows)
+	table.Render()
+}
+
+// compare adds a Drift if the expected and actual values of a field differ
+func (d *Drifts) compare(assetType, name, class, region, field string, expected, actual interface{}) {
+	e := fmt.Sprint(expected)
+	a := fmt.Sprint(actual)
+	if e == a {
+		return
+	}
+
+	*d = append(*d, Drift{
+		AssetType: assetType,
+		Name:      name,
+		Class:     class,
+		Field:     field,
+		Expected:  e,
+		Actual:    a,
+		Region:    region,
+	})
+}
+
+// missingClass adds a Drift for an asset that is tagged with a class that does not exist
+func (d *Drifts) missingClass(assetType, name, class, region string) {
+	d.compare(assetType, name, class, region, "Class", class, "class not found")
+}
+
+// cidrMask returns the /netmask of a CIDR block, which is all that Vpc and Subnet classes keep
+func cidrMask(cidr string) string {
+	if i := strings.Index(cidr, "/"); i >= 0 {
+		return cidr[i:]
+	}
+	return cidr
+}
+
+// sortedList returns a sorted, comma separated copy of a list for comparing
+func sortedList(list []string) string {
+	sorted := append([]string{}, list...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ", ")
+}
+
+func (d *Drifts) autoScaleGroups(region string) error {
+	asgList := new(AutoScaleGroups)
+	if region != "" {
+		err := GetRegionAutoScaleGroups(region, asgList, "")
+		if err != nil {
+			return err
+		}
+	} else {
+		var errs []error
+		asgList, errs = GetAutoScaleGroups("")
+		if len(errs) > 0 {
+			return errors.New("Error gathering AutoScaling Group list")
+		}
+	}
+
+	azs, errs := regions.GetAZs()
+	if errs != nil {
+		return errors.New("Error Verifying Availability Zones")
+	}
+
+	alarmLists := make(map[string]*Alarms)
+	policyLists := make(map[string]*ScalingPolicies)
+
+	for _, asg := range *asgList {
+		if asg.Class == "" {
+			continue
+		}
+
+		cfg, err := config.LoadAutoscalingGroupClass(asg.Class)
+		if err != nil {
+			d.missingClass("autoscalegroups", asg.Name, asg.Class, asg.Region)
+			continue
+		}
+
+		compare := func(field string, expected, actual interface{}) {
+			d.compare("autoscalegroups", asg.Name, asg.Class, asg.Region, field, expected, actual)
+		}
+
+		compare("Launch Configuration Class", cfg.LaunchConfigurationClass, versionSuffix.ReplaceAllString(asg.LaunchConfig, ""))
+		compare("Min Size", cfg.MinSize, asg.MinSize)
+		compare("Max Size", cfg.MaxSize, asg.MaxSize)
+
+		// Scaling policies move the desired capacity around, it only drifts when it leaves the class bounds
+		if asg.DesiredCapacity < cfg.MinSize || asg.DesiredCapacity > cfg.MaxSize {
+			compare("Desired Capacity", fmt.Sprintf("%d - %d", cfg.MinSize, cfg.MaxSize), asg.DesiredCapacity)
+		}
+		compare("Default Cooldown", cfg.DefaultCooldown, asg.DefaultCooldown)
+		compare("Health Check Type", cfg.HealthCheckType, asg.HealthCheckType)
+		compare("Health Check Grace Period", cfg.HealthCheckGracePeriod, asg.HealthCheckGracePeriod)
+		compare("Load Balancers", sortedList(cfg.LoadBalancerNames), sortedList(asg.LoadBalancers))
+
+		// Classes list zones in every region, only the ones in this region apply
+		var cfgAZs []string
+		for _, az := range cfg.AvailabilityZones {
+			if azs.GetRegion(az) == asg.Region {
+				cfgAZs = append(cfgAZs, az)
+			}
+		}
+		compare("Availability Zones", sortedList(cfgAZs), sortedList(asg.AvailabilityZones))
+
+		if asg.SubnetID != "" {
+			subList := new(Subnets)
+			GetRegionSubnets(asg.Region, subList, "")
+			subnetClasses := subList.GetSubnetClasses(strings.Split(asg.SubnetID, ","))
+			for _, subnetClass := range subnetClasses {
+				if subnetClass != cfg.SubnetClass {
+					compare("Subnet Class", cfg.SubnetClass, strings.Join(subnetClasses, ", "))
+					break
+				}
+			}
+		}
+
+		if _, ok := alarmLists[asg.Region]; !ok {
+			alarmLists[asg.Region] = new(Alarms)
+			err := GetRegionAlarms(asg.Region, alarmLists[asg.Region], "")
+			if err != nil {
+				return err
+			}
+
+			policyLists[asg.Region] = new(ScalingPolicies)
+			err = GetRegionScalingPolicies(asg.Region, policyLists[asg.Region], "")
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, alarmName := range cfg.Alarms {
+			d.alarm(asg, alarmName, alarmLists[asg.Region], policyLists[asg.Region])
+		}
+	}
+
+	return nil
+}
+
+// alarm compares an AutoScaling Group alarm and the scaling policies it triggers with their classes
+func (d *Drifts) alarm(asg AutoScaleGroup, alarmName string, alarmList *Alarms, policyList *ScalingPolicies) {
+	compare := func(field string, expected, actual interface{}) {
+		d.compare("autoscalegroups", asg.Name, asg.Class, asg.Region, field, expected, actual)
+	}
+
+	alarmCfg, err := config.LoadAlarmClass(alarmName)
+	if err != nil {
+		compare("Alarm ["+alarmName+"] Class", alarmName, "class not found")
+		return
+	}
+
+	var alarm *Alarm
+	for i, a := range *alarmList {
+		if a.Name == alarmName {
+			alarm = &(*alarmList)[i]
+		}
+	}
+
+	if alarm == nil {
+		compare("Alarm ["+alarmName+"]", "present", "missing")
+	} else {
+		compare("Alarm ["+alarmName+"] Metric", alarmCfg.MetricName, alarm.MetricName)
+		compare("Alarm ["+alarmName+"] Statistic", alarmCfg.Statistic, alarm.Statistic)
+		compare("Alarm ["+alarmName+"] Comparison Operator", alarmCfg.ComparisonOperator, alarm.Operator)
+		compare("Alarm ["+alarmName+"] Threshold", alarmCfg.Threshold, alarm.Threshold)
+		compare("Alarm ["+alarmName+"] Period", alarmCfg.Period, alarm.Period)
+		compare("Alarm ["+alarmName+"] Evaluation Periods", alarmCfg.EvaluationPeriods, alarm.EvalPeriods)
+		compare("Alarm ["+alarmName+"] Dimensions", "AutoScalingGroupName = "+asg.Name, alarm.Dimensions)
+	}
+
+	// Alarm actions name the scaling policy classes they trigger
+	for _, policyName := range alarmCfg.AlarmActions {
+		policyCfg, err := config.LoadScalingPolicyClass(policyName)
+		if err != nil {
+			continue
+		}
+
+		var policy *ScalingPolicy
+		for i, p := range *policyList {
+			if p.Name == policyName && p.AutoScaleGroupName == asg.Name {
+				policy = &(*policyList)[i]
+			}
+		}
+
+		if policy == nil {
+			compare("Scaling Policy ["+policyName+"]", "present", "missing")
+			continue
+		}
+
+		compare("Scaling Policy ["+policyName+"] Adjustment", policyCfg.ScalingAdjustment, policy.Adjustment)
+		compare("Scaling Policy ["+policyName+"] Adjustment Type", policyCfg.AdjustmentType, policy.AdjustmentType)
+		compare("Scaling Policy ["+policyName+"] Cooldown", policyCfg.Cooldown, policy.Cooldown)
+	}
+}
+
+func (d *Drifts) instances(region string) error {
+	instList := new(Instances)
+	if region != "" {
+		err := GetRegionInstances(region, instList, "", false)
+		if err != nil {
+			return err
+		}
+	} else {
+		var errs []error
+		instList, errs = GetInstances("", false)
+		if len(errs) > 0 {
+			return errors.New("Error gathering Instance list")
+		}
+	}
+
+	// Mixed instances AutoScaling Groups can launch any of their instance type overrides
+	overrides := make(map[string][]string)
+	asgCfgs, err := config.LoadAllAutoscalingGroupClasses()
+	if err != nil {
+		return err
+	}
+	for _, asgCfg := range asgCfgs {
+		overrides[asgCfg.LaunchConfigurationClass] = append(overrides[asgCfg.LaunchConfigurationClass], asgCfg.InstanceTypeOverrides...)
+	}
+
+	for _, instance := range *instList {
+		if instance.Class == "" || instance.State == "terminated" || instance.State == "shutting-down" {
+			continue
+		}
+
+		// AutoScaling Group instances are tagged with their Launch Configuration class
+		cfg, err := config.LoadInstanceClass(instance.Class)
+		if err != nil {
+			lcCfg, lcErr := config.LoadLaunchConfigurationClass(instance.Class)
+			if lcErr != nil {
+				d.missingClass("instances", instance.Name, instance.Class, instance.Region)
+				continue
+			}
+			cfg, err = config.LoadInstanceClass(lcCfg.InstanceClass)
+			if err != nil {
+				d.missingClass("instances", instance.Name, lcCfg.InstanceClass, instance.Region)
+				continue
+			}
+		}
+
+		compare := func(field string, expected, actual interface{}) {
+			d.compare("instances", instance.Name, instance.Class, instance.Region, field, expected, actual)
+		}
+
+		instanceTypes := append([]string{cfg.InstanceType}, overrides[instance.Class]...)
+		allowed := false
+		for _, instanceType := range instanceTypes {
+			if instanceType == instance.Size {
+				allowed = true
+			}
+		}
+		if !allowed {
+			compare("Instance Type", strings.Join(instanceTypes, " / "), instance.Size)
+		}
+		compare("EBS Optimized", cfg.EbsOptimized, instance.EbsOptimized)
+		if cfg.KeyName != "" {
+			compare("Key Name", cfg.KeyName, versionSuffix.ReplaceAllString(instance.KeyPair, ""))
+		}
+	}
+
+	return nil
+}
+
+func (d *Drifts) volumes(region string) error {
+	volList := new(Volumes)
+	if region != "" {
+		err := GetRegionVolumes(region, volList, "", false)
+		if err != nil {
+			return err
+		}
+	} else {
+		var errs []error
+		volList, errs = GetVolumes("", false)
+		if len(errs) > 0 {
+			return errors.New("Error gathering Volume list")
+		}
+	}
+
+	for _, volume := range *volList {
+		if volume.Class == "" {
+			continue
+		}
+
+		cfg, err := config.LoadVolumeClass(volume.Class)
+		if err != nil {
+			d.missingClass("volumes", volume.Name, volume.Class, volume.Region)
+			continue
+		}
+
+		compare := func(field string, expected, actual interface{}) {
+			d.compare("volumes", volume.Name, volume.Class, volume.Region, field, expected, actual)
+		}
+
+		compare("Volume Size", cfg.VolumeSize, volume.Size)
+		compare("Volume Type", cfg.VolumeType, volume.VolumeType)
+		compare("Encrypted", cfg.Encrypted, volume.Encrypted)
+		if cfg.Iops > 0 {
+			compare("IOPS", strconv.Itoa(cfg.Iops), volume.Iops)
+		}
+	}
+
+	return nil
+}
+
+func (d *Drifts) vpcs(region string) error {
+	vpcList := new(Vpcs)
+	if region != "" {
+		err := GetRegionVpcs(region, vpcList, "")
+		if err != nil {
+			return err
+		}
+	} else {
+		var errs []error
+		vpcList, errs = GetVpcs("")
+		if len(errs) > 0 {
+			return errors.New("Error gathering VPC list")
+		}
+	}
+
+	for _, vpc := range *vpcList {
+		if vpc.Class == "" {
+			continue
+		}
+
+		cfg, err := config.LoadVpcClass(vpc.Class)
+		if err != nil {
+			d.missingClass("vpcs", vpc.Name, vpc.Class, vpc.Region)
+			continue
+		}
+
+		d.compare("vpcs", vpc.Name, vpc.Class, vpc.Region, "CIDR", cidrMask(cfg.CIDR), cidrMask(vpc.CIDRBlock))
+		d.compare("vpcs", vpc.Name, vpc.Class, vpc.Region, "Tenancy", cfg.Tenancy, vpc.Tenancy)
+	}
+
+	return nil
+}
+
+func (d *Drifts) subnets(region string) error {
+	subList := new(Subnets)
+	if region != "" {
+		err := GetRegionSubnets(region, subList, "")
+		if err != nil {
+			return err
+		}
+	} else {
+		var errs []error
+		subList, errs = GetSubnets("")
+		if len(errs) > 0 {
+			return errors.New("Error gathering Subnet list")
+		}
+	}
+
+	for _, subnet := range *subList {
+		if subnet.Class == "" {
+			continue
+		}
+
+		cfg, err := config.LoadSubnetClass(subnet.Class)
+		if err != nil {
+			d.missingClass("subnets", subnet.Name, subnet.Class, subnet.Region)
+			continue
+		}
+
+		d.compare("subnets", subnet.Name, subnet.Class, subnet.Region, "CIDR", cidrMask(cfg.CIDR), cidrMask(subnet.CIDRBlock))
+	}
+
+	return nil
+}
+
+// securityGroups reports the grant changes that updateSecurityGroups would make as drift
+func (d *Drifts) securityGroups(region string) error {
+	secGrpList := new(SecurityGroups)
+	if region != "" {
+		err := GetRegionSecurityGroups(region, secGrpList, "")
+		if err != nil {
+			return err
+		}
+	} else {
+		var errs []error
+		secGrpList, errs = GetSecurityGroups("")
+		if len(errs) > 0 {
+			return errors.New("Error gathering Security Group list")
+		}
+	}
+
+	managed := SecurityGroups{}
+	for _, secGrp := range *secGrpList {
+		if secGrp.Class == "" {
+			continue
+		}
+
+		if _, err := config.LoadSecurityGroupClass(secGrp.Class, true); err != nil {
+			d.missingClass("securitygroups", secGrp.Name, secGrp.Class, secGrp.Region)
+			continue
+		}
+
+		managed = append(managed, secGrp)
+	}
+
+	if len(managed) == 0 {
+		return nil
+	}
+
+	changes, err := managed.diff(false)
+	if err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		secGrp := change.Group
+		for _, grant := range change.Grants {
+			field := fmt.Sprintf("%s Grant [%s :%d-%d] [%s]", strings.Title(change.Type), grant.IPProtocol, grant.FromPort, grant.ToPort, securityGroupGrantSources(grant))
+
+			switch {
+			case change.UpdateDescriptions:
+				d.compare("securitygroups", secGrp.Name, secGrp.Class, secGrp.Region, field+" Note", grant.Note, "different")
+			case change.Revoke:
+				d.compare("securitygroups", secGrp.Name, secGrp.Class, secGrp.Region, field, "absent", "present")
+			default:
+				d.compare("securitygroups", secGrp.Name, secGrp.Class, secGrp.Region, field, "present", "missing")
+			}
+		}
+	}
+
+	return nil
+}
+
+// loadBalancers reports the changes that updateLoadBalancers would make as drift
+func (d *Drifts) loadBalancers(region string) error {
+	lbList := new(LoadBalancers)
+	if region != "" {
+		err := GetRegionLoadBalancers(region, lbList, "")
+		if err != nil {
+			return err
+		}
+	} else {
+		var errs []error
+		lbList, errs = GetLoadBalancers("")
+		if len(errs) > 0 {
+			return errors.New("Error gathering Load Balancer list")
+		}
+	}
+
+	managed := LoadBalancers{}
+	for _, lb := range *lbList {
+		if lb.Class == "" {
+			continue
+		}
+
+		if _, err := config.LoadLoadBalancerClass(lb.Class); err != nil {
+			d.missingClass("loadbalancers", lb.Name, lb.Class, lb.Region)
+			continue
+		}
+
+		managed = append(managed, lb)
+	}
+
+	if len(managed) == 0 {
+		return nil
+	}
+
+	changes, err := managed.diff(false)
+	if err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		lb := change.LoadBalancer
+		compare := func(field string, expected, actual interface{}) {
+			d.compare("loadbalancers", lb.Name, lb.Class, lb.Region, field, expected, actual)
+		}
+
+		if change.Attributes != (config.LoadBalancerAttributes{}) {
+			compare("Attributes", fmt.Sprintf("%+v", change.Attributes), fmt.Sprintf("%+v", lb.LoadBalancerAttributes))
+		}
+
+		if change.HealthCheck != (config.LoadBalancerHealthCheck{}) {
+			compare("Health Check", fmt.Sprintf("%+v", change.HealthCheck), fmt.Sprintf("%+v", lb.LoadBalancerHealthCheck))
+		}
+
+		if len(change.SecurityGroups) > 0 {
+			compare("Security Group IDs", sortedList(change.SecurityGroups), sortedList(lb.SecurityGroupIDs))
+		}
+
+		for _, listener := range change.Listeners {
+			field := fmt.Sprintf("Listener [%s:%d - %s:%d]", listener.Protocol, listener.LoadBalancerPort, listener.InstanceProtocol, listener.InstancePort)
+			if change.Revoke {
+				compare(field, "absent", "present")
+			} else {
+				compare(field, "present", "missing")
+			}
+		}
+
+		for _, az := range change.AvailabilityZones {
+			if change.Disable {
+				compare("Availability Zone ["+az+"]", "absent", "present")
+			} else {
+				compare("Availability Zone ["+az+"]", "present", "missing")
+			}
+		}
+
+		for _, subnet := range change.Subnets {
+			if change.Detach {
+				compare("Subnet ["+subnet+"]", "absent", "present")
+			} else {
+				compare("Subnet ["+subnet+"]", "present", "missing")
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,375 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/murdinc/awsm/models"
+	"github.com/murdinc/terminal"
+	"github.com/olekukonko/tablewriter"
+)
+
+// HealthChecks represents a slice of AWS Route53 Health Checks
+type HealthChecks []HealthCheck
+
+// HealthCheck represents a single Route53 Health Check
+type HealthCheck models.HealthCheck
+
+// route53Svc returns a Route53 client, Route53 is global so any region will do
+func route53Svc() *route53.Route53 {
+	regions := GetRegionListWithoutIgnored()
+
+	rand.Seed(time.Now().UnixNano())
+	region := regions[rand.Intn(len(regions))] // pick a random region
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: region.RegionName}))
+	return route53.New(sess)
+}
+
+// GetHealthChecks returns a list of Route53 Health Checks that match the provided search term
+func GetHealthChecks(search string) (*HealthChecks, error) {
+
+	healthCheckList := new(HealthChecks)
+	svc := route53Svc()
+
+	var healthChecksResult []*route53.HealthCheck
+	err := svc.ListHealthChecksPages(&route53.ListHealthChecksInput{}, func(page *route53.ListHealthChecksOutput, lastPage bool) bool {
+		healthChecksResult = append(healthChecksResult, page.HealthChecks...)
+		return true
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return healthCheckList, errors.New(awsErr.Message())
+		}
+		return healthCheckList, err
+	}
+
+	// Name tags, in batches of 10
+	names := make(map[string]string)
+	for i := 0; i < len(healthChecksResult); i += 10 {
+		end := i + 10
+		if end > len(healthChecksResult) {
+			end = len(healthChecksResult)
+		}
+
+		var ids []*string
+		for _, hc := range healthChecksResult[i:end] {
+			ids = append(ids, hc.Id)
+		}
+
+		tags, err := svc.ListTagsForResources(&route53.ListTagsForResourcesInput{
+			ResourceIds:  ids,
+			ResourceType: aws.String("healthcheck"),
+		})
+		if err != nil {
+			return healthCheckList, err
+		}
+
+		for _, tagSet := range tags.ResourceTagSets {
+			for _, tag := range tagSet.Tags {
+				if aws.StringValue(tag.Key) == "Name" {
+					names[aws.StringValue(tagSet.ResourceId)] = aws.StringValue(tag.Value)
+				}
+			}
+		}
+	}
+
+	healthChecks := make(HealthChecks, len(healthChecksResult))
+	for i, hc := range healthChecksResult {
+		healthChecks[i].Marshal(hc, names[aws.StringValue(hc.Id)])
+	}
+
+	if search != "" {
+		term := regexp.MustCompile(search)
+	Loop:
+		for i, hc := range healthChecks {
+			rHealthCheck := reflect.ValueOf(hc)
+
+			for k := 0; k < rHealthCheck.NumField(); k++ {
+				sVal := rHealthCheck.Field(k).String()
+
+				if term.MatchString(sVal) {
+					*healthCheckList = append(*healthCheckList, healthChecks[i])
+					continue Loop
+				}
+			}
+		}
+	} else {
+		*healthCheckList = append(*healthCheckList, healthChecks[:]...)
+	}
+
+	// Status of the matching checks
+	for i, hc := range *healthCheckList {
+		(*healthCheckList)[i].Status = getHealthCheckStatus(svc, hc.Id)
+	}
+
+	return healthCheckList, nil
+}
+
+// getHealthCheckStatus returns a summary of how many Route53 health checkers currently see a Health Check as healthy
+func getHealthCheckStatus(svc *route53.Route53, id string) string {
+	resp, err := svc.GetHealthCheckStatus(&route53.GetHealthCheckStatusInput{
+		HealthCheckId: aws.String(id),
+	})
+	if err != nil {
+		return "unknown"
+	}
+
+	healthy := 0
+	for _, observation := range resp.HealthCheckObservations {
+		if observation.StatusReport != nil && strings.HasPrefix(aws.StringValue(observation.StatusReport.Status), "Success") {
+			healthy++
+		}
+	}
+
+	return fmt.Sprintf("%d/%d healthy", healthy, len(resp.HealthCheckObservations))
+}
+
+// Marshal parses the response from the aws sdk into an awsm HealthCheck
+func (h *HealthCheck) Marshal(healthCheck *route53.HealthCheck, name string) {
+	cfg := healthCheck.HealthCheckConfig
+
+	h.Name = name
+	h.Id = aws.StringValue(healthCheck.Id)
+	h.Type = aws.StringValue(cfg.Type)
+	h.IPAddress = aws.StringValue(cfg.IPAddress)
+	h.FQDN = aws.StringValue(cfg.FullyQualifiedDomainName)
+	h.Port = int(aws.Int64Value(cfg.Port))
+	h.ResourcePath = aws.StringValue(cfg.ResourcePath)
+	h.RequestInterval = int(aws.Int64Value(cfg.RequestInterval))
+	h.FailureThreshold = int(aws.Int64Value(cfg.FailureThreshold))
+
+	h.Target = h.FQDN
+	if h.IPAddress != "" {
+		h.Target = h.IPAddress
+	}
+}
+
+// PrintTable Prints an ascii table of the list of HealthChecks
+func (h *HealthChecks) PrintTable() {
+	if len(*h) == 0 {
+		terminal.ShowErrorMessage("Warning", "No Route53 Health Checks Found!")
+		return
+	}
+
+	var header []string
+	rows := make([][]string, len(*h))
+
+	for index, hc := range *h {
+		models.ExtractAwsmTable(index, hc, &header, &rows)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(header)
+	table.AppendBulk(rows)
+	table.Render()
+}
+
+// findHealthCheck returns the single Health Check that matches the provided search term
+func findHealthCheck(search string) (HealthCheck, error) {
+	healthChecks, err := GetHealthChecks(search)
+	if err != nil {
+		return HealthCheck{}, err
+	}
+
+	switch len(*healthChecks) {
+	case 0:
+		return HealthCheck{}, errors.New("No Health Checks found matching [" + search + "]!")
+	case 1:
+		return (*healthChecks)[0], nil
+	}
+
+	// Prefer an exact name or id match
+	for _, hc := range *healthChecks {
+		if hc.Name == search || hc.Id == search {
+			return hc, nil
+		}
+	}
+
+	healthChecks.PrintTable()
+	return HealthCheck{}, errors.New("Please limit your search to return only one Health Check.")
+}
+
+// CreateHealthCheck creates a Route53 HTTP, HTTPS or TCP Health Check against an IP Address or domain name
+func CreateHealthCheck(name, target, port, path, checkType string, interval, threshold int, dryRun bool) error {
+
+	// --dry-run flag
+	if dryRun {
+		terminal.Information("--dry-run flag is set, not making any actual changes!")
+	}
+
+	checkType = strings.ToUpper(checkType)
+
+	var portInt int64
+	switch checkType {
+	case "HTTP":
+		portInt = 80
+	case "HTTPS":
+		portInt = 443
+	case "TCP":
+		if port == "" {
+			return errors.New("A port is required for TCP Health Checks!")
+		}
+		if path != "" {
+			return errors.New("TCP Health Checks can not have a path!")
+		}
+	default:
+		return errors.New("Health Check type [" + checkType + "] is invalid! Must be one of: HTTP, HTTPS, TCP")
+	}
+
+	if port != "" {
+		var err error
+		portInt, err = strconv.ParseInt(port, 10, 64)
+		if err != nil || portInt < 1 || portInt > 65535 {
+			return errors.New("Unable to use port value [" + port + "]")
+		}
+	}
+
+	if interval != 10 && interval != 30 {
+		return errors.New("Health Check interval must be either 10 or 30 seconds!")
+	}
+
+	if threshold < 1 || threshold > 10 {
+		return errors.New("Health Check failure threshold must be between 1 and 10!")
+	}
+
+	healthCheckConfig := &route53.HealthCheckConfig{
+		Type:             aws.String(checkType),
+		Port:             aws.Int64(portInt),
+		RequestInterval:  aws.Int64(int64(interval)),
+		FailureThreshold: aws.Int64(int64(threshold)),
+	}
+
+	switch {
+	case govalidator.IsIP(target):
+		terminal.Information("Target [" + target + "] appears to be a valid IP Address.")
+		healthCheckConfig.SetIPAddress(target)
+	case validRecord(target):
+		terminal.Information("Target [" + target + "] appears to be a valid domain name.")
+		healthCheckConfig.SetFullyQualifiedDomainName(target)
+	default:
+		return errors.New("Target [" + target + "] is not a valid IP Address or domain name!")
+	}
+
+	if path != "" {
+		if !strings.HasPrefix(path, "/") {
+			path = "/" + path
+		}
+		healthCheckConfig.SetResourcePath(path)
+	}
+
+	terminal.Delta(fmt.Sprintf("Creating [%s] Health Check [%s] against [%s:%d%s]", checkType, name, target, portInt, path))
+
+	if dryRun {
+		return nil
+	}
+
+	svc := route53Svc()
+
+	resp, err := svc.CreateHealthCheck(&route53.CreateHealthCheckInput{
+		CallerReference:   aws.String(fmt.Sprintf("awsm-%s-%d", name, time.Now().UnixNano())),
+		HealthCheckConfig: healthCheckConfig,
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return errors.New(awsErr.Message())
+		}
+		return err
+	}
+
+	id := aws.StringValue(resp.HealthCheck.Id)
+
+	_, err = svc.ChangeTagsForResource(&route53.ChangeTagsForResourceInput{
+		ResourceId:   aws.String(id),
+		ResourceType: aws.String("healthcheck"),
+		AddTags: []*route53.Tag{
+			{
+				Key:   aws.String("Name"),
+				Value: aws.String(name),
+			},
+		},
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return errors.New(awsErr.Message())
+		}
+		return err
+	}
+
+	terminal.Delta("Created Health Check [" + name + "] with id [" + id + "]!")
+
+	return nil
+}
+
+// DeleteHealthChecks deletes Route53 Health Checks that match the provided search term
+func DeleteHealthChecks(search string, dryRun bool) error {
+
+	// --dry-run flag
+	if dryRun {
+		terminal.Information("--dry-run flag is set, not making any actual changes!")
+	}
+
+	healthCheckList, err := GetHealthChecks(search)
+	if err != nil {
+		return errors.New("Error gathering Health Check list")
+	}
+
+	if len(*healthCheckList) > 0 {
+		// Print the table
+		healthCheckList.PrintTable()
+	} else {
+		return errors.New("No Health Checks found, Aborting!")
+	}
+
+	// Confirm
+	if !terminal.PromptBool("Are you sure you want to delete these Health Checks?") {
+		return errors.New("Aborting!")
+	}
+
+	// Delete 'Em
+	err = deleteHealthChecks(healthCheckList, dryRun)
+	if err != nil {
+		return err
+	}
+
+	terminal.Information("Done!")
+
+	return nil
+}
+
+// Private function without the confirmation terminal prompts
+func deleteHealthChecks(healthCheckList *HealthChecks, dryRun bool) error {
+	if dryRun {
+		return nil
+	}
+
+	svc := route53Svc()
+
+	for _, hc := range *healthCheckList {
+		_, err := svc.DeleteHealthCheck(&route53.DeleteHealthCheckInput{
+			HealthCheckId: aws.String(hc.Id),
+		})
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok {
+				return errors.New(awsErr.Message())
+			}
+			return err
+		}
+
+		terminal.Delta("Deleted Health Check [" + hc.Name + "] with id [" + hc.Id + "]!")
+	}
+
+	return nil
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/ec2metadata"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/murdinc/awsm/aws/regions"
 	"github.com/murdinc/awsm/models"
 	"github.com/murdinc/terminal"
 	"github.com/olekukonko/tablewriter"
@@ -70,11 +71,18 @@ func DeleteResourceRecords(search string, dryRun bool) error {
 	for _, record := range *resourceRecordList {
 		changeSet[record.HostedZoneId] = append(changeSet[record.HostedZoneId],
 			ResourceRecordChange{
-				Action: "DELETE",
-				Name:   record.Name,
-				Values: record.Values,
-				Type:   record.Type,
-				TTL:    record.TTL,
+				Action:        "DELETE",
+				Name:          record.Name,
+				Values:        record.Values,
+				Type:          record.Type,
+				TTL:           record.TTL,
+				SetIdentifier: record.SetIdentifier,
+				RoutingPolicy: record.RoutingPolicy,
+				Weight:        record.Weight,
+				Failover:      record.Failover,
+				Region:        record.Region,
+				HealthCheckId: record.HealthCheckId,
+				AliasTarget:   record.AliasTarget,
 			},
 		)
 	}
@@ -101,11 +109,34 @@ func (h *HostedZone) GetResourceRecords(search string) (*ResourceRecords, error)
 	return resourceRecordList, nil
 }
 
-// CreateResourceRecord creates an AWS Route53 Resource Record
-func CreateResourceRecord(name, value string, ttl string, force, private, dryRun bool) error {
+// CreateResourceRecord creates an AWS Route53 Resource Record. Weighted, failover and latency record sets are created when a set identifier
+// is given along with a weight (0-255), a failover role (primary or secondary) or a latency region, optionally associated with a health check.
+// Alias records pointing at a classic or application load balancer are created when aliasLb is set.
+func CreateResourceRecord(name, value, ttl, aliasLb, setIdentifier, failover, latencyRegion, healthCheck string, weight int, evaluateTargetHealth, force, private, dryRun bool) error {
+
+	// Routing policy
+	routingPolicy, err := recordRoutingPolicy(setIdentifier, failover, latencyRegion, weight)
+	if err != nil {
+		return err
+	}
+
+	// Alias Target
+	var aliasTarget models.AliasTarget
+	if aliasLb != "" {
+		if value != "" {
+			return errors.New("Alias records can not also have a value!")
+		}
+
+		aliasTarget, err = findAliasLoadBalancer(aliasLb, evaluateTargetHealth)
+		if err != nil {
+			return err
+		}
+	} else if evaluateTargetHealth {
+		return errors.New("Evaluate target health can only be used with alias records!")
+	}
 
 	// If we were not passed a value, try to get it from the ec2metadata instead
-	if value == "" {
+	if value == "" && aliasLb == "" {
 		terminal.Notice("No value given, attempting to get value from ec2 meta-data...")
 		sess := session.Must(session.NewSession())
 		svc := ec2metadata.New(sess)
@@ -150,10 +181,15 @@ func CreateResourceRecord(name, value string, ttl string, force, private, dryRun
 	}
 
 	var ttlInt int64
-	if ttl == "" {
+	switch {
+	case aliasLb != "":
+		if ttl != "" {
+			terminal.Notice("Alias records use the TTL of their target, ignoring TTL [" + ttl + "]")
+		}
+	case ttl == "":
 		ttlInt = 300
 		terminal.Information("Using default TTL of [300]")
-	} else {
+	default:
 		var err error
 		ttlInt, err = strconv.ParseInt(ttl, 10, 64)
 		if err != nil {
@@ -173,6 +209,10 @@ func CreateResourceRecord(name, value string, ttl string, force, private, dryRun
 	default:
 		return errors.New("Value [" + value + "] is of an unknown type!")
 
+	case aliasLb != "":
+		terminal.Information("Creating an alias record for [" + aliasTarget.DNSName + "]")
+		recordType = "A"
+
 	case govalidator.IsIPv4(value):
 		terminal.Information("Value [" + value + "] appears to be a valid IPv4 Address.")
 		recordType = "A"
@@ -190,17 +230,46 @@ func CreateResourceRecord(name, value string, ttl string, force, private, dryRun
 
 	terminal.Information(fmt.Sprintf("Found Hosted Zone [%s - %s] with [%d] existing records.", hostedZone.Id, hostedZone.Name, hostedZone.ResourceRecordSetCount))
 
+	change := ResourceRecordChange{
+		Action:        action,
+		Name:          name,
+		Type:          recordType,
+		TTL:           int(ttlInt),
+		AliasTarget:   aliasTarget,
+		SetIdentifier: setIdentifier,
+		RoutingPolicy: routingPolicy,
+	}
+
+	if value != "" {
+		change.Values = []string{value}
+	}
+
+	switch routingPolicy {
+	case "Weighted":
+		change.Weight = weight
+	case "Failover":
+		change.Failover = strings.ToUpper(failover)
+	case "Latency":
+		change.Region = latencyRegion
+	}
+
+	if routingPolicy != "Simple" {
+		terminal.Information("Using [" + routingPolicy + "] routing with set identifier [" + setIdentifier + "]")
+	}
+
+	// Health Check
+	if healthCheck != "" {
+		hc, err := findHealthCheck(healthCheck)
+		if err != nil {
+			return err
+		}
+		terminal.Information("Found Health Check [" + hc.Name + "] with id [" + hc.Id + "]")
+		change.HealthCheckId = hc.Id
+	}
+
 	changeSet := make(map[string][]ResourceRecordChange)
 
-	changeSet[hostedZone.Id] = append(changeSet[hostedZone.Id],
-		ResourceRecordChange{
-			Action: action,
-			Name:   name,
-			Values: []string{value},
-			Type:   recordType,
-			TTL:    int(ttlInt),
-		},
-	)
+	changeSet[hostedZone.Id] = append(changeSet[hostedZone.Id], change)
 
 	err = changeResourceRecord(changeSet, dryRun)
 	if !force && err != nil && strings.Contains(err.Error(), "already exists") {
@@ -218,6 +287,106 @@ func CreateResourceRecord(name, value string, ttl string, force, private, dryRun
 	return err
 }
 
+// findAliasLoadBalancer returns an Alias Target for the single classic or application load balancer that matches the provided search term
+func findAliasLoadBalancer(search string, evaluateTargetHealth bool) (models.AliasTarget, error) {
+
+	var targets []models.AliasTarget
+	var names []string
+	var exact []models.AliasTarget
+
+	lbList, errs := GetLoadBalancers(search)
+	if len(errs) > 0 {
+		return models.AliasTarget{}, errors.New("Error gathering Load Balancer list")
+	}
+	for _, lb := range *lbList {
+		target := models.AliasTarget{
+			DNSName:              lb.DNSName,
+			HostedZoneId:         lb.CanonicalHostedZoneID,
+			EvaluateTargetHealth: evaluateTargetHealth,
+		}
+		targets = append(targets, target)
+		names = append(names, lb.Name+" ("+lb.Region+")")
+		if lb.Name == search {
+			exact = append(exact, target)
+		}
+	}
+
+	// Application Load Balancers don't have a search of their own
+	term := regexp.MustCompile(search)
+	lbV2List, errs := GetLoadBalancersV2()
+	if len(errs) > 0 {
+		return models.AliasTarget{}, errors.New("Error gathering Application Load Balancer list")
+	}
+	for _, lb := range *lbV2List {
+		if !term.MatchString(lb.Name) && !term.MatchString(lb.DNSName) {
+			continue
+		}
+		target := models.AliasTarget{
+			DNSName:              lb.DNSName,
+			HostedZoneId:         lb.CanonicalHostedZoneID,
+			EvaluateTargetHealth: evaluateTargetHealth,
+		}
+		targets = append(targets, target)
+		names = append(names, lb.Name+" ("+lb.Region+")")
+		if lb.Name == search {
+			exact = append(exact, target)
+		}
+	}
+
+	switch {
+	case len(targets) == 0:
+		return models.AliasTarget{}, errors.New("No Load Balancers found matching [" + search + "]!")
+	case len(targets) == 1:
+		return targets[0], nil
+	case len(exact) == 1:
+		return exact[0], nil
+	}
+
+	return models.AliasTarget{}, errors.New("Found multiple Load Balancers [" + strings.Join(names, ", ") + "], please limit your search to return only one.")
+}
+
+// recordRoutingPolicy validates the routing options of a record set and returns its routing policy
+func recordRoutingPolicy(setIdentifier, failover, latencyRegion string, weight int) (string, error) {
+
+	var policies []string
+	if weight >= 0 {
+		if weight > 255 {
+			return "", errors.New("Record weight must be between 0 and 255!")
+		}
+		policies = append(policies, "Weighted")
+	}
+	if failover != "" {
+		switch strings.ToUpper(failover) {
+		case "PRIMARY", "SECONDARY":
+		default:
+			return "", errors.New("Failover value [" + failover + "] is invalid! Must be either primary or secondary")
+		}
+		policies = append(policies, "Failover")
+	}
+	if latencyRegion != "" {
+		if !regions.ValidRegion(latencyRegion) {
+			return "", errors.New("Region [" + latencyRegion + "] is Invalid!")
+		}
+		policies = append(policies, "Latency")
+	}
+
+	switch {
+	case len(policies) > 1:
+		return "", errors.New("Only one of weight, failover or latency region can be used on a record set!")
+
+	case len(policies) == 1 && setIdentifier == "":
+		return "", errors.New(policies[0] + " record sets require a set identifier!")
+
+	case len(policies) == 0 && setIdentifier != "":
+		return "", errors.New("A set identifier requires a weight, failover or latency region!")
+
+	case len(policies) == 0:
+		return "Simple", nil
+	}
+
+	return policies[0], nil
+}
+
 func findHostedZone(name string) (HostedZone, error) {
 
 	// bad assumption stripping the first chunk?
@@ -263,38 +432,67 @@ func changeResourceRecord(changeSet map[string][]ResourceRecordChange, dryRun bo
 				ResourceRecordSet: &route53.ResourceRecordSet{
 					Name: aws.String(change.Name),
 					Type: aws.String(change.Type),
-					TTL:  aws.Int64(int64(change.TTL)),
 					/*
-						AliasTarget: &route53.AliasTarget{
-							DNSName:              aws.String("DNSName"),    // Required
-							EvaluateTargetHealth: aws.Bool(true),           // Required
-							HostedZoneId:         aws.String("ResourceId"), // Required
-						},
-						SetIdentifier: aws.String("ResourceRecordSetIdentifier"),
-						TTL:           aws.Int64(1),
 						TrafficPolicyInstanceId: aws.String("TrafficPolicyInstanceId"),
-						Failover: aws.String("ResourceRecordSetFailover"),
 						GeoLocation: &route53.GeoLocation{
 							ContinentCode:   aws.String("GeoLocationContinentCode"),
 							CountryCode:     aws.String("GeoLocationCountryCode"),
 							SubdivisionCode: aws.String("GeoLocationSubdivisionCode"),
 						},
-						HealthCheckId: aws.String("HealthCheckId"),
-						Region:        aws.String("ResourceRecordSetRegion"),
-						Weight:                  aws.Int64(1),
 					*/
 				},
 			}
 
+			recordSet := recordChanges[i].ResourceRecordSet
+
+			// Alias records have no TTL or values of their own
+			if change.AliasTarget.DNSName != "" {
+				recordSet.SetAliasTarget(&route53.AliasTarget{
+					DNSName:              aws.String(change.AliasTarget.DNSName),
+					EvaluateTargetHealth: aws.Bool(change.AliasTarget.EvaluateTargetHealth),
+					HostedZoneId:         aws.String(change.AliasTarget.HostedZoneId),
+				})
+			} else {
+				recordSet.SetTTL(int64(change.TTL))
+			}
+
+			if change.SetIdentifier != "" {
+				recordSet.SetSetIdentifier(change.SetIdentifier)
+
+				switch change.RoutingPolicy {
+				case "Weighted":
+					recordSet.SetWeight(int64(change.Weight))
+				case "Failover":
+					recordSet.SetFailover(change.Failover)
+				case "Latency":
+					recordSet.SetRegion(change.Region)
+				}
+			}
+
+			if change.HealthCheckId != "" {
+				recordSet.SetHealthCheckId(change.HealthCheckId)
+			}
+
 			resourceRecords := make([]*route53.ResourceRecord, len(change.Values))
 			for j, value := range change.Values {
 				resourceRecords[j] = new(route53.ResourceRecord)
 				resourceRecords[j].SetValue(value)
 			}
 
-			terminal.Delta("[" + change.Action + "] - Resource Record [" + change.Name + "] : [" + strings.Join(change.Values, ", ") + "]")
+			values := strings.Join(change.Values, ", ")
+			if change.AliasTarget.DNSName != "" {
+				values = "ALIAS " + change.AliasTarget.DNSName
+			}
+
+			if change.SetIdentifier != "" {
+				terminal.Delta("[" + change.Action + "] - Resource Record [" + change.Name + "] (" + change.RoutingPolicy + " - " + change.SetIdentifier + ") : [" + values + "]")
+			} else {
+				terminal.Delta("[" + change.Action + "] - Resource Record [" + change.Name + "] : [" + values + "]")
+			}
 
-			recordChanges[i].ResourceRecordSet.SetResourceRecords(resourceRecords)
+			if len(resourceRecords) > 0 {
+				recordChanges[i].ResourceRecordSet.SetResourceRecords(resourceRecords)
+			}
 
 		}
 
@@ -486,6 +684,23 @@ func (h *ResourceRecord) Marshal(resourceRecordSet *route53.ResourceRecordSet, h
 	h.Region = aws.StringValue(resourceRecordSet.Region)
 	h.Failover = aws.StringValue(resourceRecordSet.Failover)
 	h.HostedZoneId = hostedZoneId
+	h.SetIdentifier = aws.StringValue(resourceRecordSet.SetIdentifier)
+	h.Weight = int(aws.Int64Value(resourceRecordSet.Weight))
+
+	switch {
+	case resourceRecordSet.Weight != nil:
+		h.RoutingPolicy = "Weighted"
+	case h.Failover != "":
+		h.RoutingPolicy = "Failover"
+	case h.Region != "":
+		h.RoutingPolicy = "Latency"
+	case resourceRecordSet.GeoLocation != nil:
+		h.RoutingPolicy = "Geolocation"
+	case h.SetIdentifier != "":
+		h.RoutingPolicy = "Multivalue"
+	default:
+		h.RoutingPolicy = "Simple"
+	}
 
 	h.HealthCheckId = aws.StringValue(resourceRecordSet.HealthCheckId)
 
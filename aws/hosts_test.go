@@ -0,0 +1,44 @@
+package aws
+
+import "testing"
+
+// Latency record sets validate their region against the EC2 API, so they are left out here
+func TestRecordRoutingPolicy(t *testing.T) {
+	tests := []struct {
+		setIdentifier string
+		failover      string
+		weight        int
+		want          string
+		wantErr       bool
+	}{
+		{weight: -1, want: "Simple"},
+		{setIdentifier: "blue", weight: -1, wantErr: true},
+		{setIdentifier: "blue", weight: 10, want: "Weighted"},
+		{setIdentifier: "blue", weight: 0, want: "Weighted"},
+		{setIdentifier: "blue", weight: 255, want: "Weighted"},
+		{setIdentifier: "blue", weight: 256, wantErr: true},
+		{weight: 10, wantErr: true},
+		{setIdentifier: "blue", failover: "primary", weight: -1, want: "Failover"},
+		{setIdentifier: "blue", failover: "SECONDARY", weight: -1, want: "Failover"},
+		{setIdentifier: "blue", failover: "tertiary", weight: -1, wantErr: true},
+		{failover: "primary", weight: -1, wantErr: true},
+		{setIdentifier: "blue", failover: "primary", weight: 5, wantErr: true},
+	}
+
+	for _, test := range tests {
+		got, err := recordRoutingPolicy(test.setIdentifier, test.failover, "", test.weight)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("recordRoutingPolicy(%q, %q, \"\", %d) = %q, want an error", test.setIdentifier, test.failover, test.weight, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("recordRoutingPolicy(%q, %q, \"\", %d) returned an error: %s", test.setIdentifier, test.failover, test.weight, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("recordRoutingPolicy(%q, %q, \"\", %d) = %q, want %q", test.setIdentifier, test.failover, test.weight, got, test.want)
+		}
+	}
+}
@@ -39,6 +39,16 @@ func (i *Images) GetImageName(id string) string {
 	return id
 }
 
+// GetRootDeviceName returns the root device name of an AMI, if it is in the list
+func (i *Images) GetRootDeviceName(id string) string {
+	for _, img := range *i {
+		if img.ImageID == id {
+			return img.RootDevice
+		}
+	}
+	return ""
+}
+
 // GetImagesByTag returns a slice of Amazon Machine Images given the provided region, and tag key/values
 func GetImagesByTag(region, key, value string, available bool) (Images, error) {
 
@@ -190,7 +200,7 @@ func GetRegionImages(region string, imgList *Images, search string, available bo
 }
 
 // CopyImage copies an existing AMI to another region
-func CopyImage(search, region string, dryRun bool) error {
+func CopyImage(search, region string, encrypt bool, kmsKeyId string, dryRun bool) error {
 
 	// --dry-run flag
 	if dryRun {
@@ -215,7 +225,7 @@ func CopyImage(search, region string, dryRun bool) error {
 	image := (*images)[0]
 
 	// Copy image to the destination region
-	copyImageResp, err := copyImage(image, region, dryRun)
+	copyImageResp, err := copyImage(image, region, encrypt, kmsKeyId, dryRun)
 
 	if err != nil {
 		return err
@@ -228,7 +238,7 @@ func CopyImage(search, region string, dryRun bool) error {
 }
 
 // private function without prompts
-func copyImage(image Image, region string, dryRun bool) (*ec2.CopyImageOutput, error) {
+func copyImage(image Image, region string, encrypt bool, kmsKeyId string, dryRun bool) (*ec2.CopyImageOutput, error) {
 
 	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
 	svc := ec2.New(sess)
@@ -241,8 +251,13 @@ func copyImage(image Image, region string, dryRun bool) (*ec2.CopyImageOutput, e
 		DryRun:        aws.Bool(dryRun),
 		//ClientToken: aws.String("String"),
 		//Description: aws.String("String"),
-		//Encrypted:   aws.Bool(true),
-		//KmsKeyId:    aws.String("String"),
+	}
+
+	if encrypt {
+		params.SetEncrypted(true)
+		if kmsKeyId != "" {
+			params.SetKmsKeyId(kmsKeyId)
+		}
 	}
 	copyImageResp, err := svc.CopyImage(params)
 
@@ -337,6 +352,7 @@ func CreateImage(class, search string, dryRun bool) error {
 	if cfg.Propagate && cfg.PropagateRegions != nil {
 
 		var wg sync.WaitGroup
+		var mu sync.Mutex
 		var errs []error
 
 		terminal.Notice("Propagate flag is set, waiting for initial image to complete...")
@@ -357,15 +373,31 @@ func CreateImage(class, search string, dryRun bool) error {
 					defer wg.Done()
 
 					// Copy image to the destination region
-					copyImageResp, err := copyImage(sourceImage, propRegion, dryRun)
+					copyImageResp, err := copyImage(sourceImage, propRegion, cfg.Encrypt, cfg.KmsKeyIds[propRegion], dryRun)
 
 					if err != nil {
 						terminal.ShowErrorMessage(fmt.Sprintf("Error propagating image [%s] to region [%s]", sourceImage.ImageID, propRegion), err.Error())
+						mu.Lock()
 						errs = append(errs, err)
+						mu.Unlock()
 					} else {
 						// Add Tags
 						err = SetEc2NameAndClassTags(copyImageResp.ImageId, name, class, propRegion)
 						terminal.Delta(fmt.Sprintf("Copied image [%s] to region [%s].", sourceImage.ImageID, propRegion))
+
+						// Share the copy once it is available
+						if len(cfg.ShareAccounts) > 0 {
+							err = waitForImage(*copyImageResp.ImageId, propRegion, dryRun)
+							if err == nil {
+								err = shareImage(*copyImageResp.ImageId, propRegion, cfg.ShareAccounts, false, dryRun)
+							}
+							if err != nil {
+								terminal.ShowErrorMessage(fmt.Sprintf("Error sharing image [%s] in region [%s]", *copyImageResp.ImageId, propRegion), err.Error())
+								mu.Lock()
+								errs = append(errs, err)
+								mu.Unlock()
+							}
+						}
 					}
 
 				}(propRegion)
@@ -379,6 +411,21 @@ func CreateImage(class, search string, dryRun bool) error {
 		}
 	}
 
+	// Share the new image with other accounts
+	if len(cfg.ShareAccounts) > 0 {
+		terminal.Notice("Share Accounts are set, waiting for image to be available...")
+
+		err = waitForImage(*createImageResp.ImageId, region, dryRun)
+		if err != nil {
+			return err
+		}
+
+		err = shareImage(*createImageResp.ImageId, region, cfg.ShareAccounts, false, dryRun)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Rotate out older images
 	if cfg.Rotate && cfg.Retain > 1 {
 		terminal.Notice("Rotate flag is set, looking for images to rotate...")
@@ -395,6 +442,7 @@ func CreateImage(class, search string, dryRun bool) error {
 // rotateImages rotates out images based on the "retain" number set in the Image class
 func rotateImages(class string, cfg config.ImageClass, dryRun bool) error {
 	var wg sync.WaitGroup
+	var mu sync.Mutex
 	var errs []error
 
 	launchConfigs, err := GetLaunchConfigurations("")
@@ -415,7 +463,9 @@ func rotateImages(class string, cfg config.ImageClass, dryRun bool) error {
 			images, err := GetImagesByTag(*region.RegionName, "Class", class, false)
 			if err != nil {
 				terminal.ShowErrorMessage(fmt.Sprintf("Error gathering image list for region [%s]", *region.RegionName), err.Error())
+				mu.Lock()
 				errs = append(errs, err)
+				mu.Unlock()
 			}
 
 			var unlockedImages Images
@@ -432,7 +482,21 @@ func rotateImages(class string, cfg config.ImageClass, dryRun bool) error {
 			// Delete the oldest ones if we have more than the retention number
 			if len(unlockedImages) > cfg.Retain {
 				sort.Sort(unlockedImages) // important!
-				di := unlockedImages[cfg.Retain:]
+				var di Images
+
+				// Revoke any launch permissions before deleting, keeping images that are still shared
+				for _, image := range unlockedImages[cfg.Retain:] {
+					err := revokeImageLaunchPermissions(image.ImageID, image.Region, dryRun)
+					if err != nil {
+						terminal.ShowErrorMessage(fmt.Sprintf("Error revoking launch permissions on image [%s] in region [%s], skipping!", image.ImageID, image.Region), err.Error())
+						mu.Lock()
+						errs = append(errs, err)
+						mu.Unlock()
+						continue
+					}
+					di = append(di, image)
+				}
+
 				deleteImages(&di, dryRun)
 			}
 
@@ -536,6 +600,7 @@ func (i *Image) Marshal(image *ec2.Image, region string) {
 	i.VolumeSize = volSize
 	i.Region = region
 	i.AmiName = aws.StringValue(image.Name)
+	i.RootDevice = aws.StringValue(image.RootDeviceName)
 
 	// Fall back to AMI Name
 	if i.Name == "" {
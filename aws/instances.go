@@ -99,6 +99,7 @@ func (i *Instance) Marshal(instance *ec2.Instance, region string, subList *Subne
 	i.VPC = vpc
 	i.SubnetID = aws.StringValue(instance.SubnetId)
 	i.Subnet = subnet
+	i.SpotRequestID = aws.StringValue(instance.SpotInstanceRequestId)
 	i.Region = region
 
 	if instance.IamInstanceProfile != nil && instance.IamInstanceProfile.Arn != nil {
@@ -107,8 +108,9 @@ func (i *Instance) Marshal(instance *ec2.Instance, region string, subList *Subne
 		i.IamInstanceProfileName = iamInstanceProfileName.ProfileName
 	}
 
-	// TODO
-	//instance.SecurityGroups
+	for _, group := range instance.SecurityGroups {
+		i.SecurityGroupIDs = append(i.SecurityGroupIDs, aws.StringValue(group.GroupId))
+	}
 }
 
 // GetRegionInstances returns a slice of Instances into the passed Instances slice based on the provided region and search term, and optional running flag
@@ -129,10 +131,23 @@ func GetRegionInstances(region string, instList *Instances, search string, runni
 	GetRegionVpcs(region, vpcList, "")
 	GetRegionImages(region, imgList, "", false)
 
+	var spotStates map[string]string
+
 	for _, reservation := range result.Reservations {
 		inst := make(Instances, len(reservation.Instances))
 		for i, instance := range reservation.Instances {
 			inst[i].Marshal(instance, region, subList, vpcList, imgList)
+
+			// Spot request state
+			if inst[i].SpotRequestID != "" {
+				if spotStates == nil {
+					spotStates, err = getRegionSpotRequestStates(region)
+					if err != nil {
+						return err
+					}
+				}
+				inst[i].SpotState = spotStates[inst[i].SpotRequestID]
+			}
 		}
 
 		if search != "" {
@@ -165,6 +180,29 @@ func GetRegionInstances(region string, instList *Instances, search string, runni
 	return nil
 }
 
+// getRegionSpotRequestStates returns a map of the state of each Spot Instance Request in a region, keyed by request ID
+func getRegionSpotRequestStates(region string) (map[string]string, error) {
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := ec2.New(sess)
+
+	result, err := svc.DescribeSpotInstanceRequests(&ec2.DescribeSpotInstanceRequestsInput{})
+	if err != nil {
+		return map[string]string{}, err
+	}
+
+	states := make(map[string]string)
+	for _, request := range result.SpotInstanceRequests {
+		state := aws.StringValue(request.State)
+		if request.Status != nil && request.Status.Code != nil {
+			state += " (" + aws.StringValue(request.Status.Code) + ")"
+		}
+		states[aws.StringValue(request.SpotInstanceRequestId)] = state
+	}
+
+	return states, nil
+}
+
 // PrintTable Prints an ascii table of the list of Instances
 func (i *Instances) PrintTable() {
 	if len(*i) == 0 {
@@ -301,17 +339,18 @@ func LaunchInstance(class, sequence, az string, dryRun bool) error {
 	}
 
 	// KeyPair
-	keyPair, err := GetKeyPairByName(region, instanceCfg.KeyName)
+	keyName := currentKeyPairName(instanceCfg.KeyName)
+	keyPair, err := GetKeyPairByName(region, keyName)
 	if err != nil {
 		// Try to create it?
-		terminal.Information("Unable to find KeyPair [" + instanceCfg.KeyName + "] in [" + region + "], trying to create it...")
+		terminal.Information("Unable to find KeyPair [" + keyName + "] in [" + region + "], trying to create it...")
 
 		err = CreateKeyPair(instanceCfg.KeyName, region, dryRun)
 		if err != nil {
 			return err
 		}
 
-		keyPair, err = GetKeyPairByName(region, instanceCfg.KeyName)
+		keyPair, err = GetKeyPairByName(region, keyName)
 		if err != nil {
 			return err
 		}
@@ -395,6 +434,7 @@ func LaunchInstance(class, sequence, az string, dryRun bool) error {
 					Value: region,
 				},
 			},
+			FuncMap: userDataFuncs(region, dryRun),
 		},
 	}
 
@@ -458,6 +498,30 @@ func LaunchInstance(class, sequence, az string, dryRun bool) error {
 		params.BlockDeviceMappings = ebsVolumes
 	}
 
+	if instanceCfg.MarketType == "spot" {
+		// Interrupted persistent requests are fulfilled again, so AWS only allows them to stop or hibernate, terminate is the default
+		if instanceCfg.SpotRequestType == "persistent" && (instanceCfg.SpotInterruptionBehavior == "" || instanceCfg.SpotInterruptionBehavior == "terminate") {
+			return errors.New("Instance class [" + class + "] uses persistent spot requests, which need a Spot Interruption Behavior of stop or hibernate!")
+		}
+
+		spotOptions := &ec2.SpotMarketOptions{}
+
+		if instanceCfg.SpotMaxPrice != "" {
+			spotOptions.SetMaxPrice(instanceCfg.SpotMaxPrice)
+		}
+		if instanceCfg.SpotInterruptionBehavior != "" {
+			spotOptions.SetInstanceInterruptionBehavior(instanceCfg.SpotInterruptionBehavior)
+		}
+		if instanceCfg.SpotRequestType != "" {
+			spotOptions.SetSpotInstanceType(instanceCfg.SpotRequestType)
+		}
+
+		params.SetInstanceMarketOptions(&ec2.InstanceMarketOptionsRequest{
+			MarketType:  aws.String("spot"),
+			SpotOptions: spotOptions,
+		})
+	}
+
 	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
 	svc := ec2.New(sess)
 
@@ -467,14 +531,17 @@ func LaunchInstance(class, sequence, az string, dryRun bool) error {
 	}
 
 	launchInstanceResp, err := svc.RunInstances(params)
-	if err != nil {
-		if awsErr, ok := err.(awserr.Error); ok {
-			return errors.New(awsErr.Message())
+
+	// Fall back to on-demand if there is no spot capacity available
+	if err != nil && params.InstanceMarketOptions != nil {
+		if awsErr, ok := err.(awserr.Error); ok && spotCapacityUnavailable(awsErr.Code()) {
+			terminal.Notice("Spot capacity is unavailable [" + awsErr.Code() + "], falling back to an on-demand Instance...")
+
+			params.InstanceMarketOptions = nil
+			launchInstanceResp, err = svc.RunInstances(params)
 		}
 	}
 
-	instance := launchInstanceResp.Instances[0]
-
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
 			return errors.New(awsErr.Message())
@@ -482,6 +549,8 @@ func LaunchInstance(class, sequence, az string, dryRun bool) error {
 		return err
 	}
 
+	instance := launchInstanceResp.Instances[0]
+
 	terminal.Delta("Launching Instance:")
 
 	inst := make(Instances, 1)
@@ -583,6 +652,15 @@ func LaunchInstance(class, sequence, az string, dryRun bool) error {
 	return nil
 }
 
+// spotCapacityUnavailable returns true if the RunInstances error code means that a spot instance can not be fulfilled right now
+func spotCapacityUnavailable(code string) bool {
+	switch code {
+	case "InsufficientInstanceCapacity", "SpotMaxPriceTooLow", "MaxSpotInstanceCountExceeded":
+		return true
+	}
+	return false
+}
+
 // TerminateInstances terminates EC2 instances based on the given search term and optional region input
 func TerminateInstances(search, region string, dryRun bool) (err error) {
 
@@ -11,6 +11,7 @@ import (
 	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/murdinc/awsm/aws/regions"
@@ -173,7 +174,7 @@ func CreateKeyPair(class, region string, dryRun bool) error {
 	}
 
 	// Import the KeyPair to the requested region
-	err = importKeyPair(region, class, []byte(keypairCfg.PublicKey), dryRun)
+	err = importKeyPair(region, keypairCfg.KeyName(class), []byte(keypairCfg.PublicKey), dryRun)
 	if err != nil {
 		return err
 	}
@@ -202,6 +203,173 @@ func importKeyPair(region, name string, publicKey []byte, dryRun bool) error {
 	return nil
 }
 
+// currentKeyPairName returns the name of the current version of a KeyPair class as imported into AWS, falling back to the class name
+func currentKeyPairName(class string) string {
+	keypairCfg, err := config.LoadKeyPairClass(class)
+	if err != nil {
+		return class
+	}
+	return keypairCfg.KeyName(class)
+}
+
+// RotateKeyPair generates a new key for a KeyPair class and imports it under a versioned name into every region where the current key exists.
+// The old key is only deleted once no running instances or Launch Configurations reference it anymore.
+func RotateKeyPair(class string, dryRun bool) error {
+
+	if dryRun {
+		terminal.Information("--dry-run flag is set, not making any actual changes!")
+	}
+
+	// KeyPair Class Config
+	keypairCfg, err := config.LoadKeyPairClass(class)
+	if err != nil {
+		return err
+	}
+
+	terminal.Information("Found KeyPair class configuration for [" + class + "]!")
+
+	oldName := keypairCfg.KeyName(class)
+	newVersion := keypairCfg.Version + 1
+	newName := fmt.Sprintf("%s-v%d", class, newVersion)
+
+	// Find the regions with the current key
+	keyList, _ := GetKeyPairs("^" + regexp.QuoteMeta(oldName) + "$")
+
+	var oldKeys KeyPairs
+	for _, key := range *keyList {
+		if key.KeyName == oldName {
+			oldKeys = append(oldKeys, key)
+		}
+	}
+
+	if len(oldKeys) == 0 {
+		return errors.New("No KeyPairs found named [" + oldName + "] in any region, Aborting!")
+	}
+
+	oldKeys.PrintTable()
+
+	// Confirm
+	if !terminal.PromptBool("Are you sure you want to replace these KeyPairs with a new key named [" + newName + "]?") {
+		return errors.New("Aborting!")
+	}
+
+	if dryRun {
+		for _, key := range oldKeys {
+			terminal.Notice("Would have imported KeyPair [" + newName + "] into [" + key.Region + "]")
+		}
+	} else {
+		terminal.Information("Generating a new key...")
+		publicKey, privateKey, err := config.GenerateKeyPair()
+		if err != nil {
+			return err
+		}
+
+		var imported KeyPairs
+		for _, key := range oldKeys {
+			err = importKeyPair(key.Region, newName, []byte(publicKey), dryRun)
+			if err != nil {
+				if awsErr, ok := err.(awserr.Error); ok {
+					err = errors.New(awsErr.Message())
+				}
+
+				// Don't leave the new key in only some of the regions
+				for _, newKey := range imported {
+					deleteErr := deleteKeyPair(newKey, dryRun)
+					if deleteErr != nil {
+						terminal.ErrorLine(deleteErr.Error())
+					}
+				}
+
+				return err
+			}
+			imported = append(imported, KeyPair{KeyName: newName, Region: key.Region})
+		}
+
+		err = keypairCfg.SetKeys(class, publicKey, privateKey, newVersion)
+		if err != nil {
+			return err
+		}
+
+		terminal.Delta("Updated KeyPair class [" + class + "] to version [" + fmt.Sprint(newVersion) + "], new instances and Launch Configurations will use [" + newName + "]!")
+	}
+
+	// Report what is still using the old key
+	inUse := false
+
+	instList, _ := GetInstances("", true)
+	var oldInstances Instances
+	for _, instance := range *instList {
+		if instance.KeyPair == oldName {
+			oldInstances = append(oldInstances, instance)
+		}
+	}
+	if len(oldInstances) > 0 {
+		inUse = true
+		terminal.Notice("These running instances still use the KeyPair [" + oldName + "]:")
+		oldInstances.PrintTable()
+	}
+
+	lcList, _ := GetLaunchConfigurations("")
+	var oldLaunchConfigs LaunchConfigs
+	for _, lc := range *lcList {
+		if lc.KeyName == oldName {
+			oldLaunchConfigs = append(oldLaunchConfigs, lc)
+		}
+	}
+	if len(oldLaunchConfigs) > 0 {
+		inUse = true
+		terminal.Notice("These Launch Configurations still use the KeyPair [" + oldName + "]:")
+		oldLaunchConfigs.PrintTable()
+	}
+
+	if inUse {
+		terminal.Notice("Not deleting the KeyPair [" + oldName + "] while it is still in use, run deleteKeyPairs " + oldName + " once these are replaced.")
+		return nil
+	}
+
+	terminal.Information("No running instances or Launch Configurations use the KeyPair [" + oldName + "] anymore.")
+
+	// Confirm
+	if !terminal.PromptBool("Do you want to delete the KeyPair [" + oldName + "] from every region now?") {
+		return nil
+	}
+
+	for _, key := range oldKeys {
+		err := deleteKeyPair(key, dryRun)
+		if err != nil {
+			terminal.ErrorLine(err.Error())
+		}
+	}
+
+	return nil
+}
+
+// deleteKeyPair deletes a single KeyPair
+func deleteKeyPair(key KeyPair, dryRun bool) error {
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(key.Region)}))
+	svc := ec2.New(sess)
+
+	params := &ec2.DeleteKeyPairInput{
+		KeyName: aws.String(key.KeyName),
+		DryRun:  aws.Bool(dryRun),
+	}
+
+	_, err := svc.DeleteKeyPair(params)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == "DryRunOperation" {
+				return nil
+			}
+			return errors.New(awsErr.Message())
+		}
+		return err
+	}
+
+	terminal.Delta("Deleted KeyPair [" + key.KeyName + "] in region [" + key.Region + "]!")
+
+	return nil
+}
+
 // DeleteKeyPairs deletes an existing KeyPair from AWS
 func DeleteKeyPairs(name string, dryRun bool) error {
 
@@ -268,14 +436,15 @@ func InstallKeyPair(class string, dryRun bool) error {
 		currentUser, _ := user.Current()
 		sshLocation := currentUser.HomeDir + "/.ssh/"
 
-		privateKeyPath := sshLocation + class + ".pem"
-		publicKeyPath := sshLocation + class + ".pub"
+		keyName := keypairCfg.KeyName(class)
+		privateKeyPath := sshLocation + keyName + ".pem"
+		publicKeyPath := sshLocation + keyName + ".pub"
 
 		// Private Key
 		privateKey := []byte(keypairCfg.PrivateKey1 + keypairCfg.PrivateKey2 + keypairCfg.PrivateKey3 + keypairCfg.PrivateKey4)
 
 		if _, err := os.Stat(privateKeyPath); !os.IsNotExist(err) {
-			terminal.ErrorLine("Local private key named [" + class + "] already exists!")
+			terminal.ErrorLine("Local private key named [" + keyName + "] already exists!")
 
 		} else if len(privateKey) < 1 {
 			terminal.ErrorLine("Private key length is 0, not writing file!")
@@ -291,7 +460,7 @@ func InstallKeyPair(class string, dryRun bool) error {
 
 		// Public Key
 		if _, err := os.Stat(publicKeyPath); !os.IsNotExist(err) {
-			terminal.ErrorLine("Local public key named [" + class + "] already exists!")
+			terminal.ErrorLine("Local public key named [" + keyName + "] already exists!")
 
 		} else if len(keypairCfg.PublicKey) < 1 {
 			terminal.ErrorLine("Public key length is 0, not writing file!")
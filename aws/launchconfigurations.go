@@ -195,10 +195,33 @@ func (l *LaunchConfig) Marshal(config *autoscaling.LaunchConfiguration, region s
 	l.CreationTime = aws.TimeValue(config.CreatedTime)
 	l.EbsOptimized = aws.BoolValue(config.EbsOptimized)
 	l.SecurityGroups = strings.Join(secGroupNamesSorted, ", ")
+	l.SecurityGroupIDs = aws.StringValueSlice(config.SecurityGroups)
 	l.Region = region
+	l.UserData = aws.StringValue(config.UserData)
+	l.IamInstanceProfile = aws.StringValue(config.IamInstanceProfile)
+	l.RootDeviceName = imgList.GetRootDeviceName(l.ImageID)
+
+	for _, mapping := range config.BlockDeviceMappings {
+		device := models.LaunchConfigBlockDevice{
+			DeviceName:  aws.StringValue(mapping.DeviceName),
+			VirtualName: aws.StringValue(mapping.VirtualName),
+			NoDevice:    aws.BoolValue(mapping.NoDevice),
+		}
+
+		if mapping.Ebs != nil {
+			device.SnapshotID = aws.StringValue(mapping.Ebs.SnapshotId)
+			device.VolumeType = aws.StringValue(mapping.Ebs.VolumeType)
+			device.VolumeSize = int(aws.Int64Value(mapping.Ebs.VolumeSize))
+			device.Iops = int(aws.Int64Value(mapping.Ebs.Iops))
+			device.DeleteOnTermination = aws.BoolValue(mapping.Ebs.DeleteOnTermination)
+			device.Encrypted = aws.BoolValue(mapping.Ebs.Encrypted)
+
+			if device.SnapshotID != "" {
+				l.SnapshotIDs = append(l.SnapshotIDs, device.SnapshotID)
+			}
+		}
 
-	for _, snapshot := range config.BlockDeviceMappings {
-		l.SnapshotIDs = append(l.SnapshotIDs, *snapshot.Ebs.SnapshotId)
+		l.BlockDevices = append(l.BlockDevices, device)
 	}
 }
 
@@ -257,8 +280,35 @@ func CreateLaunchConfigurations(class string, dryRun bool) (err error) {
 
 	terminal.Delta(fmt.Sprintf("New version of launch configuration is [%d]", cfg.Version))
 
+	params, err := launchConfigurationInput(class, cfg.Version, instanceCfg)
+	if err != nil {
+		return err
+	}
+
+	for _, region := range cfg.Regions {
+		err := createLaunchConfiguration(class, cfg, instanceCfg, params, region, dryRun)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Rotate out older launch configurations
+	if cfg.Retain > 1 {
+		err := RotateLaunchConfigurations(class, cfg, dryRun)
+		if err != nil {
+			terminal.ShowErrorMessage(fmt.Sprintf("Error rotating [%s] launch configurations!", class), err.Error())
+			return err
+		}
+	}
+
+	return nil
+}
+
+// launchConfigurationInput builds the region independent parameters of a Launch Configuration of a given class and version
+func launchConfigurationInput(class string, version int, instanceCfg config.InstanceClass) (*autoscaling.CreateLaunchConfigurationInput, error) {
+
 	params := &autoscaling.CreateLaunchConfigurationInput{
-		LaunchConfigurationName:  aws.String(fmt.Sprintf("%s-v%d", class, cfg.Version)),
+		LaunchConfigurationName:  aws.String(fmt.Sprintf("%s-v%d", class, version)),
 		AssociatePublicIpAddress: aws.Bool(instanceCfg.PublicIPAddress),
 		InstanceMonitoring: &autoscaling.InstanceMonitoring{
 			Enabled: aws.Bool(instanceCfg.Monitoring),
@@ -278,7 +328,7 @@ func CreateLaunchConfigurations(class string, dryRun bool) (err error) {
 	if len(instanceCfg.IAMInstanceProfile) > 0 {
 		iam, err := GetIAMInstanceProfile(instanceCfg.IAMInstanceProfile)
 		if err != nil {
-			return err
+			return params, err
 		}
 
 		terminal.Information("Found IAM Instance Profile [" + iam.ProfileName + "]")
@@ -286,187 +336,182 @@ func CreateLaunchConfigurations(class string, dryRun bool) (err error) {
 
 	}
 
-	for _, region := range cfg.Regions {
-
-		if !regions.ValidRegion(region) {
-			return errors.New("Region [" + region + "] is not valid!")
-		} else {
-			terminal.Delta("Building Launch Configuration for [" + region + "]...")
-		}
-
-		// EBS
-		ebsVolumes := make([]*autoscaling.BlockDeviceMapping, len(instanceCfg.EBSVolumes))
-		for i, ebsClass := range instanceCfg.EBSVolumes {
-			volCfg, err := config.LoadVolumeClass(ebsClass)
-			if err != nil {
-				return err
-			}
-
-			terminal.Information("Found Volume Class Configuration for [" + ebsClass + "]")
-
-			latestSnapshot, err := GetLatestSnapshotByTag(region, "Class", volCfg.Snapshot)
-			if err != nil {
-				return err
-			}
-
-			terminal.Information("Found Snapshot [" + latestSnapshot.SnapshotID + "] with class [" + latestSnapshot.Class + "] created [" + humanize.Time(latestSnapshot.StartTime) + "]")
+	return params, nil
+}
 
-			ebsVolumes[i] = &autoscaling.BlockDeviceMapping{
-				DeviceName: aws.String(volCfg.DeviceName),
-				Ebs: &autoscaling.Ebs{
-					DeleteOnTermination: aws.Bool(volCfg.DeleteOnTermination),
-					SnapshotId:          aws.String(latestSnapshot.SnapshotID),
-					VolumeSize:          aws.Int64(int64(volCfg.VolumeSize)),
-					VolumeType:          aws.String(volCfg.VolumeType),
-					//Encrypted:           aws.Bool(volCfg.Encrypted),
-				},
-				//NoDevice:    aws.String("String"),
-				//VirtualName: aws.String("String"),
-			}
+// private function without terminal prompts, creates a Launch Configuration of a given class in a single region
+func createLaunchConfiguration(class string, cfg config.LaunchConfigurationClass, instanceCfg config.InstanceClass, params *autoscaling.CreateLaunchConfigurationInput, region string, dryRun bool) error {
 
-			if volCfg.VolumeType == "io1" {
-				ebsVolumes[i].Ebs.Iops = aws.Int64(int64(volCfg.Iops))
-			}
-
-		}
+	if !regions.ValidRegion(region) {
+		return errors.New("Region [" + region + "] is not valid!")
+	} else {
+		terminal.Delta("Building Launch Configuration for [" + region + "]...")
+	}
 
-		// EBS Optimized
-		if instanceCfg.EbsOptimized {
-			terminal.Information("Launching as EBS Optimized")
-			params.EbsOptimized = aws.Bool(instanceCfg.EbsOptimized)
+	// EBS
+	ebsVolumes := make([]*autoscaling.BlockDeviceMapping, len(instanceCfg.EBSVolumes))
+	for i, ebsClass := range instanceCfg.EBSVolumes {
+		volCfg, err := config.LoadVolumeClass(ebsClass)
+		if err != nil {
+			return err
 		}
 
-		params.BlockDeviceMappings = ebsVolumes
+		terminal.Information("Found Volume Class Configuration for [" + ebsClass + "]")
 
-		// AMI
-		ami, err := GetLatestImageByTag(region, "Class", instanceCfg.AMI)
+		latestSnapshot, err := GetLatestSnapshotByTag(region, "Class", volCfg.Snapshot)
 		if err != nil {
 			return err
 		}
 
-		terminal.Information("Found AMI [" + ami.ImageID + "] with class [" + ami.Class + "] created [" + humanize.Time(ami.CreationDate) + "]")
-		params.ImageId = aws.String(ami.ImageID)
+		terminal.Information("Found Snapshot [" + latestSnapshot.SnapshotID + "] with class [" + latestSnapshot.Class + "] created [" + humanize.Time(latestSnapshot.StartTime) + "]")
 
-		// KeyPair
-		keyPair, err := GetKeyPairByName(region, instanceCfg.KeyName)
-		if err != nil {
-			return err
+		ebsVolumes[i] = &autoscaling.BlockDeviceMapping{
+			DeviceName: aws.String(volCfg.DeviceName),
+			Ebs: &autoscaling.Ebs{
+				DeleteOnTermination: aws.Bool(volCfg.DeleteOnTermination),
+				SnapshotId:          aws.String(latestSnapshot.SnapshotID),
+				VolumeSize:          aws.Int64(int64(volCfg.VolumeSize)),
+				VolumeType:          aws.String(volCfg.VolumeType),
+				//Encrypted:           aws.Bool(volCfg.Encrypted),
+			},
+			//NoDevice:    aws.String("String"),
+			//VirtualName: aws.String("String"),
 		}
 
-		terminal.Information("Found KeyPair [" + keyPair.KeyName + "] in [" + keyPair.Region + "]")
-		params.KeyName = aws.String(keyPair.KeyName)
+		if volCfg.VolumeType == "io1" {
+			ebsVolumes[i].Ebs.Iops = aws.Int64(int64(volCfg.Iops))
+		}
 
-		// VPC / Subnet
-		var vpc Vpc
-		var subnet Subnet
-		secGroupIds := make([]*string, len(instanceCfg.SecurityGroups))
-		if instanceCfg.Vpc != "" && instanceCfg.Subnet != "" {
-			// VPC
-			vpc, err = GetRegionVpcByTag(region, "Class", instanceCfg.Vpc)
-			if err != nil {
-				return err
-			}
+	}
 
-			terminal.Information("Found VPC [" + vpc.VpcID + "] in Region [" + region + "]")
+	// EBS Optimized
+	if instanceCfg.EbsOptimized {
+		terminal.Information("Launching as EBS Optimized")
+		params.EbsOptimized = aws.Bool(instanceCfg.EbsOptimized)
+	}
 
-			// Subnet
-			subnet, err = vpc.GetVpcSubnetByTag("Class", instanceCfg.Subnet)
-			if err != nil {
-				return err
-			}
+	params.BlockDeviceMappings = ebsVolumes
 
-			terminal.Information("Found Subnet [" + subnet.SubnetID + "] in VPC [" + subnet.VpcID + "]")
+	// AMI
+	ami, err := GetLatestImageByTag(region, "Class", instanceCfg.AMI)
+	if err != nil {
+		return err
+	}
 
-			// VPC Security Groups
-			secGroups, err := vpc.GetVpcSecurityGroupByTagMulti("Class", instanceCfg.SecurityGroups)
-			if err != nil {
-				return err
-			}
+	terminal.Information("Found AMI [" + ami.ImageID + "] with class [" + ami.Class + "] created [" + humanize.Time(ami.CreationDate) + "]")
+	params.ImageId = aws.String(ami.ImageID)
 
-			for i, secGroup := range secGroups {
-				terminal.Information("Found VPC Security Group [" + secGroup.GroupID + "] with name [" + secGroup.Name + "]")
-				secGroupIds[i] = aws.String(secGroup.GroupID)
-			}
+	// KeyPair
+	keyPair, err := GetKeyPairByName(region, currentKeyPairName(instanceCfg.KeyName))
+	if err != nil {
+		return err
+	}
 
-		} else {
-			terminal.Notice("No VPC and/or Subnet specified for instance Class [" + class + "]")
+	terminal.Information("Found KeyPair [" + keyPair.KeyName + "] in [" + keyPair.Region + "]")
+	params.KeyName = aws.String(keyPair.KeyName)
 
-			// EC2-Classic security groups
-			secGroups, err := GetSecurityGroupByTagMulti(region, "Class", instanceCfg.SecurityGroups)
-			if err != nil {
-				return err
-			}
+	// VPC / Subnet
+	var vpc Vpc
+	var subnet Subnet
+	secGroupIds := make([]*string, len(instanceCfg.SecurityGroups))
+	if instanceCfg.Vpc != "" && instanceCfg.Subnet != "" {
+		// VPC
+		vpc, err = GetRegionVpcByTag(region, "Class", instanceCfg.Vpc)
+		if err != nil {
+			return err
+		}
 
-			for i, secGroup := range secGroups {
-				terminal.Information("Found Security Group [" + secGroup.GroupID + "] with name [" + secGroup.Name + "]")
-				secGroupIds[i] = aws.String(secGroup.GroupID)
-			}
+		terminal.Information("Found VPC [" + vpc.VpcID + "] in Region [" + region + "]")
 
+		// Subnet
+		subnet, err = vpc.GetVpcSubnetByTag("Class", instanceCfg.Subnet)
+		if err != nil {
+			return err
 		}
 
-		// Parse Userdata
-		tree, err := hil.Parse(instanceCfg.UserData)
+		terminal.Information("Found Subnet [" + subnet.SubnetID + "] in VPC [" + subnet.VpcID + "]")
+
+		// VPC Security Groups
+		secGroups, err := vpc.GetVpcSecurityGroupByTagMulti("Class", instanceCfg.SecurityGroups)
 		if err != nil {
 			return err
 		}
 
-		config := &hil.EvalConfig{
-			GlobalScope: &ast.BasicScope{
-				VarMap: map[string]ast.Variable{
-					"var.class": ast.Variable{
-						Type:  ast.TypeString,
-						Value: class,
-					},
-					"var.sequence": ast.Variable{
-						Type:  ast.TypeInt,
-						Value: cfg.Version,
-					},
-					"var.locale": ast.Variable{
-						Type:  ast.TypeString,
-						Value: region,
-					},
-				},
-			},
+		for i, secGroup := range secGroups {
+			terminal.Information("Found VPC Security Group [" + secGroup.GroupID + "] with name [" + secGroup.Name + "]")
+			secGroupIds[i] = aws.String(secGroup.GroupID)
 		}
 
-		result, err := hil.Eval(tree, config)
+	} else {
+		terminal.Notice("No VPC and/or Subnet specified for instance Class [" + class + "]")
+
+		// EC2-Classic security groups
+		secGroups, err := GetSecurityGroupByTagMulti(region, "Class", instanceCfg.SecurityGroups)
 		if err != nil {
 			return err
 		}
 
-		parsedUserData := result.Value.(string)
+		for i, secGroup := range secGroups {
+			terminal.Information("Found Security Group [" + secGroup.GroupID + "] with name [" + secGroup.Name + "]")
+			secGroupIds[i] = aws.String(secGroup.GroupID)
+		}
 
-		params.UserData = aws.String(base64.StdEncoding.EncodeToString([]byte(parsedUserData)))
-		params.SecurityGroups = secGroupIds
+	}
 
-		if dryRun {
-			terminal.Notice("User Data:")
-			terminal.Notice(parsedUserData)
-		} else {
-			sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
-			svc := autoscaling.New(sess)
+	// Parse Userdata
+	tree, err := hil.Parse(instanceCfg.UserData)
+	if err != nil {
+		return err
+	}
 
-			_, err = svc.CreateLaunchConfiguration(params)
+	config := &hil.EvalConfig{
+		GlobalScope: &ast.BasicScope{
+			VarMap: map[string]ast.Variable{
+				"var.class": ast.Variable{
+					Type:  ast.TypeString,
+					Value: class,
+				},
+				"var.sequence": ast.Variable{
+					Type:  ast.TypeInt,
+					Value: cfg.Version,
+				},
+				"var.locale": ast.Variable{
+					Type:  ast.TypeString,
+					Value: region,
+				},
+			},
+			FuncMap: userDataFuncs(region, dryRun),
+		},
+	}
 
-			if err != nil {
-				if awsErr, ok := err.(awserr.Error); ok {
-					return errors.New(awsErr.Message())
-				}
-				return err
-			}
+	result, err := hil.Eval(tree, config)
+	if err != nil {
+		return err
+	}
 
-			terminal.Delta("Created Launch Configuration [" + class + "] in region [" + region + "]")
+	parsedUserData := result.Value.(string)
 
-		}
-	}
+	params.UserData = aws.String(base64.StdEncoding.EncodeToString([]byte(parsedUserData)))
+	params.SecurityGroups = secGroupIds
+
+	if dryRun {
+		terminal.Notice("User Data:")
+		terminal.Notice(parsedUserData)
+	} else {
+		sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+		svc := autoscaling.New(sess)
+
+		_, err = svc.CreateLaunchConfiguration(params)
 
-	// Rotate out older launch configurations
-	if cfg.Retain > 1 {
-		err := RotateLaunchConfigurations(class, cfg, dryRun)
 		if err != nil {
-			terminal.ShowErrorMessage(fmt.Sprintf("Error rotating [%s] launch configurations!", class), err.Error())
+			if awsErr, ok := err.(awserr.Error); ok {
+				return errors.New(awsErr.Message())
+			}
 			return err
 		}
+
+		terminal.Delta("Created Launch Configuration [" + class + "] in region [" + region + "]")
+
 	}
 
 	return nil
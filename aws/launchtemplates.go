@@ -0,0 +1,151 @@
+package aws
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/murdinc/terminal"
+)
+
+// createLaunchTemplateFromLaunchConfiguration creates a Launch Template with the same name and settings as an existing
+// Launch Configuration, Mixed Instances Policies can only be used with Launch Templates. Existing templates are reused.
+func createLaunchTemplateFromLaunchConfiguration(lcName, region string, dryRun bool) (string, error) {
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := ec2.New(sess)
+
+	// Check if we already have one
+	existing, err := svc.DescribeLaunchTemplates(&ec2.DescribeLaunchTemplatesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name: aws.String("launch-template-name"),
+				Values: []*string{
+					aws.String(lcName),
+				},
+			},
+		},
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return "", errors.New(awsErr.Message())
+		}
+		return "", err
+	}
+
+	if len(existing.LaunchTemplates) > 0 {
+		terminal.Information("Found Launch Template [" + lcName + "] in [" + region + "]")
+		return lcName, nil
+	}
+
+	// Get the Launch Configuration
+	asgSvc := autoscaling.New(sess)
+	lcResp, err := asgSvc.DescribeLaunchConfigurations(&autoscaling.DescribeLaunchConfigurationsInput{
+		LaunchConfigurationNames: []*string{
+			aws.String(lcName),
+		},
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return "", errors.New(awsErr.Message())
+		}
+		return "", err
+	}
+
+	if len(lcResp.LaunchConfigurations) == 0 {
+		return "", errors.New("Launch Configuration [" + lcName + "] was not found in [" + region + "]!")
+	}
+	lc := lcResp.LaunchConfigurations[0]
+
+	data := &ec2.RequestLaunchTemplateData{
+		ImageId:      lc.ImageId,
+		InstanceType: lc.InstanceType,
+		EbsOptimized: lc.EbsOptimized,
+	}
+
+	if aws.StringValue(lc.KeyName) != "" {
+		data.SetKeyName(aws.StringValue(lc.KeyName))
+	}
+
+	// Launch Configuration user data is already base64 encoded
+	if aws.StringValue(lc.UserData) != "" {
+		data.SetUserData(aws.StringValue(lc.UserData))
+	}
+
+	if lc.InstanceMonitoring != nil {
+		data.SetMonitoring(&ec2.LaunchTemplatesMonitoringRequest{
+			Enabled: lc.InstanceMonitoring.Enabled,
+		})
+	}
+
+	if iamProfile := aws.StringValue(lc.IamInstanceProfile); iamProfile != "" {
+		if strings.HasPrefix(iamProfile, "arn:") {
+			data.SetIamInstanceProfile(&ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{Arn: aws.String(iamProfile)})
+		} else {
+			data.SetIamInstanceProfile(&ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{Name: aws.String(iamProfile)})
+		}
+	}
+
+	// Public IPs need to be set on the network interface, along with the security groups
+	if aws.BoolValue(lc.AssociatePublicIpAddress) {
+		data.SetNetworkInterfaces([]*ec2.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest{
+			{
+				AssociatePublicIpAddress: aws.Bool(true),
+				DeleteOnTermination:      aws.Bool(true),
+				DeviceIndex:              aws.Int64(0),
+				Groups:                   lc.SecurityGroups,
+			},
+		})
+	} else if len(lc.SecurityGroups) > 0 {
+		data.SetSecurityGroupIds(lc.SecurityGroups)
+	}
+
+	for _, mapping := range lc.BlockDeviceMappings {
+		blockDevice := &ec2.LaunchTemplateBlockDeviceMappingRequest{
+			DeviceName:  mapping.DeviceName,
+			VirtualName: mapping.VirtualName,
+		}
+
+		if aws.BoolValue(mapping.NoDevice) {
+			blockDevice.SetNoDevice("")
+		}
+
+		if mapping.Ebs != nil {
+			blockDevice.SetEbs(&ec2.LaunchTemplateEbsBlockDeviceRequest{
+				DeleteOnTermination: mapping.Ebs.DeleteOnTermination,
+				Encrypted:           mapping.Ebs.Encrypted,
+				Iops:                mapping.Ebs.Iops,
+				SnapshotId:          mapping.Ebs.SnapshotId,
+				VolumeSize:          mapping.Ebs.VolumeSize,
+				VolumeType:          mapping.Ebs.VolumeType,
+			})
+		}
+
+		data.BlockDeviceMappings = append(data.BlockDeviceMappings, blockDevice)
+	}
+
+	params := &ec2.CreateLaunchTemplateInput{
+		LaunchTemplateName: aws.String(lcName),
+		LaunchTemplateData: data,
+		DryRun:             aws.Bool(dryRun),
+	}
+
+	_, err = svc.CreateLaunchTemplate(params)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == "DryRunOperation" {
+				return lcName, nil
+			}
+			return "", errors.New(awsErr.Message())
+		}
+		return "", err
+	}
+
+	terminal.Delta("Created Launch Template [" + lcName + "] from Launch Configuration [" + lcName + "] in [" + region + "]!")
+
+	return lcName, nil
+}
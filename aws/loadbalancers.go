@@ -224,6 +224,7 @@ func (l *LoadBalancer) Marshal(balancer *elb.LoadBalancerDescription, region str
 
 	l.Name = aws.StringValue(balancer.LoadBalancerName)
 	l.DNSName = aws.StringValue(balancer.DNSName)
+	l.CanonicalHostedZoneID = aws.StringValue(balancer.CanonicalHostedZoneNameID)
 	l.CreatedTime = aws.TimeValue(balancer.CreatedTime)
 	l.VpcID = aws.StringValue(balancer.VPCId)
 	l.Vpc = vpcList.GetVpcName(l.VpcID)
@@ -232,6 +233,7 @@ func (l *LoadBalancer) Marshal(balancer *elb.LoadBalancerDescription, region str
 	l.SubnetClasses = subnetClassesSorted
 	l.Scheme = aws.StringValue(balancer.Scheme)
 	l.SecurityGroups = secGroupNamesSorted
+	l.SecurityGroupIDs = aws.StringValueSlice(balancer.SecurityGroups)
 	l.AvailabilityZones = aws.StringValueSlice(balancer.AvailabilityZones)
 	l.Region = region
 	l.Class = GetTagValue("Class", tags[l.Name])
@@ -387,8 +389,13 @@ func CreateLoadBalancer(class, region string, dryRun bool) error {
 
 	// Add Listeners
 	if len(elbCfg.LoadBalancerListeners) > 0 {
+		cfgListeners, err := resolveListenerCertificates(elbCfg.LoadBalancerListeners, region, true)
+		if err != nil {
+			return err
+		}
+
 		listeners := []*elb.Listener{}
-		for _, l := range elbCfg.LoadBalancerListeners {
+		for _, l := range cfgListeners {
 
 			if !govalidator.IsPort(fmt.Sprint(l.InstancePort)) {
 				return errors.New("Instance Port [" + fmt.Sprint(l.InstancePort) + "] is invalid!")
@@ -403,7 +410,10 @@ func CreateLoadBalancer(class, region string, dryRun bool) error {
 				LoadBalancerPort: aws.Int64(int64(l.LoadBalancerPort)),
 				Protocol:         aws.String(l.Protocol),
 				InstanceProtocol: aws.String(l.InstanceProtocol),
-				//SSLCertificateId: aws.String("SSLCertificateId"),
+			}
+
+			if l.SSLCertificateID != "" {
+				listener.SetSSLCertificateId(l.SSLCertificateID)
 			}
 
 			listeners = append(listeners, listener)
@@ -429,8 +439,9 @@ func CreateLoadBalancer(class, region string, dryRun bool) error {
 
 }
 
-// UpdateLoadBalancers updates one or more Load Balancers that match the provided search term and optional region
-func UpdateLoadBalancers(search, region string, dryRun bool) (err error) {
+// UpdateLoadBalancers updates one or more Load Balancers that match the provided search term and optional region, or writes the changes
+// to a plan file to be applied later
+func UpdateLoadBalancers(search, region, planOut string, dryRun bool) (err error) {
 
 	// --dry-run flag
 	if dryRun {
@@ -467,6 +478,20 @@ func UpdateLoadBalancers(search, region string, dryRun bool) (err error) {
 		return nil
 	}
 
+	// --plan-out flag
+	if planOut != "" {
+		plan := Plan{Command: "updateLoadBalancers"}
+		for i := range changes {
+			plan.Changes = append(plan.Changes, PlanChange{
+				AssetType:          "loadbalancers",
+				Asset:              changes[i].LoadBalancer.Name,
+				Region:             changes[i].LoadBalancer.Region,
+				LoadBalancerChange: &changes[i],
+			})
+		}
+		return plan.Write(planOut)
+	}
+
 	// Confirm
 	if !terminal.PromptBool("Are you sure you want to update these Load Balancers?") {
 		return errors.New("Aborting!")
@@ -769,8 +794,11 @@ func addListener(lb LoadBalancer, listeners []config.LoadBalancerListener) error
 		elbListener.SetInstancePort(int64(list.InstancePort)).
 			SetLoadBalancerPort(int64(list.LoadBalancerPort)).
 			SetProtocol(list.Protocol).
-			SetInstanceProtocol(list.InstanceProtocol).
-			SetSSLCertificateId(list.SSLCertificateID)
+			SetInstanceProtocol(list.InstanceProtocol)
+
+		if list.SSLCertificateID != "" {
+			elbListener.SetSSLCertificateId(list.SSLCertificateID)
+		}
 
 		elbListeners = append(elbListeners, elbListener)
 	}
@@ -837,9 +865,26 @@ type LoadBalancerChange struct {
 	Detach            bool
 }
 
+// Diff compares Load Balancers with their classes and returns the changes needed
 func (s LoadBalancers) Diff() ([]LoadBalancerChange, error) {
+	return s.diff(true)
+}
+
+// diff compares Load Balancers with their classes, printing the changes it finds when verbose
+func (s LoadBalancers) diff(verbose bool) ([]LoadBalancerChange, error) {
 
-	terminal.Delta("Comparing awsm Load Balancer configuration...")
+	delta := func(msg string) {
+		if verbose {
+			terminal.Delta(msg)
+		}
+	}
+	information := func(msg string) {
+		if verbose {
+			terminal.Information(msg)
+		}
+	}
+
+	delta("Comparing awsm Load Balancer configuration...")
 
 	changes := []LoadBalancerChange{}
 	listenerHashes := make([]map[uint64]config.LoadBalancerListener, len(s))
@@ -869,7 +914,7 @@ func (s LoadBalancers) Diff() ([]LoadBalancerChange, error) {
 		lbAttrHash, _ := hashstructure.Hash(lb.LoadBalancerAttributes, nil)
 		cfgAttrHash, _ := hashstructure.Hash(cfg.LoadBalancerAttributes, nil)
 		if lbAttrHash != cfgAttrHash {
-			terminal.Delta(fmt.Sprintf("[%s %s] - Update -	[Load Balancer Attributes]", lb.Name, lb.Region))
+			delta(fmt.Sprintf("[%s %s] - Update -	[Load Balancer Attributes]", lb.Name, lb.Region))
 			changes = append(changes, LoadBalancerChange{
 				Attributes:   cfg.LoadBalancerAttributes,
 				LoadBalancer: lb,
@@ -882,7 +927,7 @@ func (s LoadBalancers) Diff() ([]LoadBalancerChange, error) {
 		lbHealthCheckHash, _ := hashstructure.Hash(lb.LoadBalancerHealthCheck, nil)
 		cfgHealthCheckHash, _ := hashstructure.Hash(cfg.LoadBalancerHealthCheck, nil)
 		if lbHealthCheckHash != cfgHealthCheckHash {
-			terminal.Delta(fmt.Sprintf("[%s %s] - Update -	[Load Balancer Health Check]", lb.Name, lb.Region))
+			delta(fmt.Sprintf("[%s %s] - Update -	[Load Balancer Health Check]", lb.Name, lb.Region))
 			changes = append(changes, LoadBalancerChange{
 				HealthCheck:  cfg.LoadBalancerHealthCheck,
 				LoadBalancer: lb,
@@ -898,7 +943,7 @@ func (s LoadBalancers) Diff() ([]LoadBalancerChange, error) {
 				return changes, err
 			}
 
-			terminal.Delta(fmt.Sprintf("[%s %s] - Update -	[Load Balancer Security Groups] [%s]", lb.Name, lb.Region, strings.Join(cfg.SecurityGroups, ", ")))
+			delta(fmt.Sprintf("[%s %s] - Update -	[Load Balancer Security Groups] [%s]", lb.Name, lb.Region, strings.Join(cfg.SecurityGroups, ", ")))
 
 			secGrpIds := secGrps.GetSecurityGroupIDs()
 
@@ -939,7 +984,12 @@ func (s LoadBalancers) Diff() ([]LoadBalancerChange, error) {
 		/////////////////
 		// LISTENERS
 
-		for _, cListener := range cfg.LoadBalancerListeners {
+		cListeners, err := resolveListenerCertificates(cfg.LoadBalancerListeners, lb.Region, verbose)
+		if err != nil {
+			return changes, err
+		}
+
+		for _, cListener := range cListeners {
 
 			configListenerHash, err := hashstructure.Hash(cListener, nil)
 			if err != nil {
@@ -964,7 +1014,7 @@ func (s LoadBalancers) Diff() ([]LoadBalancerChange, error) {
 				return changes, err
 			}
 			if _, ok := listenerHashes[i][existingListenerHash]; !ok {
-				terminal.Delta(fmt.Sprintf("[%s %s] - Remove -	[%s:%d	-	%s:%d]", lb.Name, lb.Region, listener.Protocol, listener.LoadBalancerPort, listener.InstanceProtocol, listener.InstancePort))
+				delta(fmt.Sprintf("[%s %s] - Remove -	[%s:%d	-	%s:%d]", lb.Name, lb.Region, listener.Protocol, listener.LoadBalancerPort, listener.InstanceProtocol, listener.InstancePort))
 				removeListener = append(removeListener, listener)
 			} else {
 				//terminal.Notice(fmt.Sprintf("[%s %s] - Keeping -	[%s:%d	-	%s:%d]", lb.Name, lb.Region, listener.Protocol, listener.LoadBalancerPort, listener.InstanceProtocol, listener.InstancePort))
@@ -974,7 +1024,7 @@ func (s LoadBalancers) Diff() ([]LoadBalancerChange, error) {
 
 		// cycle through hashes and find ones to add
 		for _, listener := range listenerHashes[i] {
-			terminal.Delta(fmt.Sprintf("[%s %s] - Add -	[%s:%d	-	%s:%d]", lb.Name, lb.Region, listener.Protocol, listener.LoadBalancerPort, listener.InstanceProtocol, listener.InstancePort))
+			delta(fmt.Sprintf("[%s %s] - Add -	[%s:%d	-	%s:%d]", lb.Name, lb.Region, listener.Protocol, listener.LoadBalancerPort, listener.InstanceProtocol, listener.InstancePort))
 			addListener = append(addListener, listener)
 		}
 
@@ -990,7 +1040,7 @@ func (s LoadBalancers) Diff() ([]LoadBalancerChange, error) {
 					return changes, err
 				}
 				if _, ok := azHashes[i][existingAzHash]; !ok {
-					terminal.Delta(fmt.Sprintf("[%s %s] - Disable -	[Load Balancer Availability Zone] [%s]", lb.Name, lb.Region, az))
+					delta(fmt.Sprintf("[%s %s] - Disable -	[Load Balancer Availability Zone] [%s]", lb.Name, lb.Region, az))
 					disableAz = append(disableAz, az)
 				} else {
 					//terminal.Notice(fmt.Sprintf("[%s %s] - Keeping -	[%s:%d	-	%s:%d]", lb.Name, lb.Region, listener.Protocol, listener.LoadBalancerPort, listener.InstanceProtocol, listener.InstancePort))
@@ -1000,7 +1050,7 @@ func (s LoadBalancers) Diff() ([]LoadBalancerChange, error) {
 
 			// cycle through hashes and find ones to add
 			for _, az := range azHashes[i] {
-				terminal.Delta(fmt.Sprintf("[%s %s] - Enable -	[Load Balancer Availability Zones] [%s]", lb.Name, lb.Region, az))
+				delta(fmt.Sprintf("[%s %s] - Enable -	[Load Balancer Availability Zones] [%s]", lb.Name, lb.Region, az))
 				enableAz = append(enableAz, az)
 			}
 
@@ -1017,7 +1067,7 @@ func (s LoadBalancers) Diff() ([]LoadBalancerChange, error) {
 					return changes, err
 				}
 				if _, ok := subnetHashes[i][existingSubnetHash]; !ok {
-					terminal.Delta(fmt.Sprintf("[%s %s] - Detach -	[Load Balancer Subnet] [%s]", lb.Name, lb.Region, subnet))
+					delta(fmt.Sprintf("[%s %s] - Detach -	[Load Balancer Subnet] [%s]", lb.Name, lb.Region, subnet))
 					detachSubnet = append(detachSubnet, subnet)
 				} else {
 					//terminal.Notice(fmt.Sprintf("[%s %s] - Keeping -	[%s:%d	-	%s:%d]", lb.Name, lb.Region, listener.Protocol, listener.LoadBalancerPort, listener.InstanceProtocol, listener.InstancePort))
@@ -1027,7 +1077,7 @@ func (s LoadBalancers) Diff() ([]LoadBalancerChange, error) {
 
 			// cycle through hashes and find ones to add
 			for _, subnet := range subnetHashes[i] {
-				terminal.Delta(fmt.Sprintf("[%s %s] - Attach -	[Load Balancer Subnet] [%s]", lb.Name, lb.Region, subnet))
+				delta(fmt.Sprintf("[%s %s] - Attach -	[Load Balancer Subnet] [%s]", lb.Name, lb.Region, subnet))
 				attachSubnet = append(attachSubnet, subnet)
 			}
 		}
@@ -1081,7 +1131,7 @@ func (s LoadBalancers) Diff() ([]LoadBalancerChange, error) {
 		}
 	}
 
-	terminal.Information("Comparison complete!")
+	information("Comparison complete!")
 	return changes, nil
 }
 
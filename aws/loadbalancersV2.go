@@ -54,9 +54,6 @@ func GetRegionLoadBalancersV2(region string, lbList *LoadBalancersV2) error {
 	svc := elbv2.New(sess)
 
 	result, err := svc.DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{})
-
-	fmt.Println(result)
-
 	if err != nil {
 		return err
 	}
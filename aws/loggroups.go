@@ -0,0 +1,361 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/dustin/go-humanize"
+	"github.com/murdinc/awsm/aws/regions"
+	"github.com/murdinc/awsm/models"
+	"github.com/murdinc/terminal"
+	"github.com/olekukonko/tablewriter"
+)
+
+// LogGroups represents a slice of CloudWatch Logs Log Groups
+type LogGroups []LogGroup
+
+// LogGroup represents a single CloudWatch Logs Log Group
+type LogGroup models.LogGroup
+
+// LogEvents represents a slice of CloudWatch Logs events
+type LogEvents []LogEvent
+
+// LogEvent represents a single CloudWatch Logs event
+type LogEvent models.LogEvent
+
+// GetLogGroups returns a slice of CloudWatch Logs Log Groups that match the provided search term
+func GetLogGroups(search string) (*LogGroups, []error) {
+	var wg sync.WaitGroup
+	var errs []error
+
+	groupList := new(LogGroups)
+	regions := GetRegionListWithoutIgnored()
+
+	for _, region := range regions {
+		wg.Add(1)
+
+		go func(region *ec2.Region) {
+			defer wg.Done()
+			err := GetRegionLogGroups(*region.RegionName, groupList, search)
+			if err != nil {
+				terminal.ShowErrorMessage(fmt.Sprintf("Error gathering log group list for region [%s]", *region.RegionName), err.Error())
+				errs = append(errs, err)
+			}
+		}(region)
+	}
+	wg.Wait()
+
+	return groupList, errs
+}
+
+// GetRegionLogGroups returns a list of a regions Log Groups into the provided LogGroups slice
+func GetRegionLogGroups(region string, groupList *LogGroups, search string) error {
+
+	// Validate the region
+	if !regions.ValidRegion(region) {
+		return errors.New("Region [" + region + "] is Invalid!")
+	}
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := cloudwatchlogs.New(sess)
+
+	var groups LogGroups
+	err := svc.DescribeLogGroupsPages(&cloudwatchlogs.DescribeLogGroupsInput{}, func(page *cloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) bool {
+		for _, logGroup := range page.LogGroups {
+			group := LogGroup{}
+			group.Marshal(logGroup, region)
+			groups = append(groups, group)
+		}
+		return true
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return errors.New(awsErr.Message())
+		}
+		return err
+	}
+
+	if search != "" {
+		term := regexp.MustCompile(search)
+	Loop:
+		for i, g := range groups {
+			rGroup := reflect.ValueOf(g)
+
+			for k := 0; k < rGroup.NumField(); k++ {
+				sVal := rGroup.Field(k).String()
+
+				if term.MatchString(sVal) {
+					*groupList = append(*groupList, groups[i])
+					continue Loop
+				}
+			}
+		}
+	} else {
+		*groupList = append(*groupList, groups[:]...)
+	}
+
+	return nil
+}
+
+// Marshal parses the response from the aws sdk into an awsm Log Group
+func (g *LogGroup) Marshal(logGroup *cloudwatchlogs.LogGroup, region string) {
+	g.Name = aws.StringValue(logGroup.LogGroupName)
+	g.RetentionDays = int(aws.Int64Value(logGroup.RetentionInDays))
+	g.StoredBytes = humanize.Bytes(uint64(aws.Int64Value(logGroup.StoredBytes)))
+	g.MetricFilters = int(aws.Int64Value(logGroup.MetricFilterCount))
+	g.CreationTime = time.Unix(0, aws.Int64Value(logGroup.CreationTime)*int64(time.Millisecond))
+	g.Arn = aws.StringValue(logGroup.Arn)
+	g.Region = region
+}
+
+// PrintTable Prints an ascii table of the list of Log Groups
+func (l *LogGroups) PrintTable() {
+	if len(*l) == 0 {
+		terminal.ShowErrorMessage("Warning", "No Log Groups Found!")
+		return
+	}
+
+	var header []string
+	rows := make([][]string, len(*l))
+
+	for index, group := range *l {
+		models.ExtractAwsmTable(index, group, &header, &rows)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(header)
+	table.AppendBulk(rows)
+	table.Render()
+}
+
+// TailLogs prints the recent events of a Log Group, optionally limited to streams with the provided prefix and events matching a filter pattern,
+// and keeps printing new events as they arrive when follow is set
+func TailLogs(search, streamPrefix, filter, since string, follow bool) error {
+
+	if since == "" {
+		since = "10m"
+	}
+	duration, err := time.ParseDuration(since)
+	if err != nil {
+		return errors.New("Unable to parse since duration [" + since + "], use a duration like 10m or 2h!")
+	}
+
+	groups, _ := GetLogGroups(search)
+	if len(*groups) == 0 {
+		return errors.New("No Log Groups found matching [" + search + "]!")
+	}
+
+	// Prefer an exact name match, since log group names are often prefixes of each other
+	group := (*groups)[0]
+	if len(*groups) > 1 {
+		var exact LogGroups
+		for _, g := range *groups {
+			if g.Name == search {
+				exact = append(exact, g)
+			}
+		}
+		if len(exact) != 1 {
+			groups.PrintTable()
+			return errors.New("Please limit your search to return only one log group.")
+		}
+		group = exact[0]
+	}
+
+	if follow {
+		terminal.Information("Following Log Group [" + group.Name + "] in [" + group.Region + "], press ctrl+c to stop...")
+	}
+
+	startTime := time.Now().Add(-duration)
+	seen := make(map[string]time.Time)
+
+	for {
+		events, err := filterLogEvents(group.Name, group.Region, streamPrefix, filter, startTime)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if _, ok := seen[event.EventID]; ok {
+				continue
+			}
+			seen[event.EventID] = event.Timestamp
+			event.Print()
+
+			if event.Timestamp.After(startTime) {
+				startTime = event.Timestamp
+			}
+		}
+
+		if !follow {
+			return nil
+		}
+
+		// Only events at or after the start time can be returned again
+		for id, timestamp := range seen {
+			if timestamp.Before(startTime) {
+				delete(seen, id)
+			}
+		}
+
+		time.Sleep(2 * time.Second)
+	}
+}
+
+// SearchLogs searches the Log Groups matching the provided search for events that match a filter pattern
+func SearchLogs(filter, search, since string) error {
+
+	if since == "" {
+		since = "1h"
+	}
+	duration, err := time.ParseDuration(since)
+	if err != nil {
+		return errors.New("Unable to parse since duration [" + since + "], use a duration like 45m or 24h!")
+	}
+
+	groups, _ := GetLogGroups(search)
+	if len(*groups) == 0 {
+		return errors.New("No Log Groups found matching [" + search + "]!")
+	}
+
+	terminal.Information(fmt.Sprintf("Searching [%d] Log Groups for [%s]...", len(*groups), filter))
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var eventList LogEvents
+	var errs []string
+
+	startTime := time.Now().Add(-duration)
+
+	for _, group := range *groups {
+		wg.Add(1)
+
+		go func(group LogGroup) {
+			defer wg.Done()
+
+			events, err := filterLogEvents(group.Name, group.Region, "", filter, startTime)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("[%s] in [%s]: %s", group.Name, group.Region, err.Error()))
+				return
+			}
+			eventList = append(eventList, events...)
+		}(group)
+	}
+	wg.Wait()
+
+	sort.Sort(eventList)
+	eventList.PrintTable()
+
+	if len(errs) > 0 {
+		return errors.New("Error searching " + strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+// private function to get the events of a Log Group, following every page
+func filterLogEvents(groupName, region, streamPrefix, filter string, startTime time.Time) (LogEvents, error) {
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := cloudwatchlogs.New(sess)
+
+	params := &cloudwatchlogs.FilterLogEventsInput{
+		LogGroupName: aws.String(groupName),
+		Interleaved:  aws.Bool(true),
+	}
+
+	if streamPrefix != "" {
+		params.SetLogStreamNamePrefix(streamPrefix)
+	}
+	if filter != "" {
+		params.SetFilterPattern(filter)
+	}
+	if !startTime.IsZero() {
+		params.SetStartTime(startTime.UnixNano() / int64(time.Millisecond))
+	}
+
+	var events LogEvents
+	err := svc.FilterLogEventsPages(params, func(page *cloudwatchlogs.FilterLogEventsOutput, lastPage bool) bool {
+		for _, filteredEvent := range page.Events {
+			event := LogEvent{}
+			event.Marshal(filteredEvent, groupName, region)
+			events = append(events, event)
+		}
+		return true
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return events, errors.New(awsErr.Message())
+		}
+		return events, err
+	}
+
+	sort.Sort(events)
+
+	return events, nil
+}
+
+// Marshal parses the response from the aws sdk into an awsm Log Event
+func (e *LogEvent) Marshal(event *cloudwatchlogs.FilteredLogEvent, groupName, region string) {
+	e.Timestamp = time.Unix(0, aws.Int64Value(event.Timestamp)*int64(time.Millisecond))
+	e.LogGroup = groupName
+	e.LogStream = aws.StringValue(event.LogStreamName)
+	e.Message = strings.TrimRight(aws.StringValue(event.Message), "\n")
+	e.EventID = aws.StringValue(event.EventId)
+	e.Region = region
+}
+
+// Print prints a single Log Event as a line of output
+func (e LogEvent) Print() {
+	fmt.Printf("%s %s %s\n", e.Timestamp.Local().Format("2006-01-02 15:04:05"), e.LogStream, e.Message)
+}
+
+// Len returns the number of Log Events
+func (l LogEvents) Len() int {
+	return len(l)
+}
+
+// Swap swaps the position of two Log Events
+func (l LogEvents) Swap(i, j int) {
+	l[i], l[j] = l[j], l[i]
+}
+
+// Less returns true if the Log Event at index i happened before the one at index j
+func (l LogEvents) Less(i, j int) bool {
+	return l[i].Timestamp.Before(l[j].Timestamp)
+}
+
+// PrintTable Prints an ascii table of the list of Log Events
+func (l *LogEvents) PrintTable() {
+	if len(*l) == 0 {
+		terminal.ShowErrorMessage("Warning", "No Log Events Found!")
+		return
+	}
+
+	var header []string
+	rows := make([][]string, len(*l))
+
+	for index, event := range *l {
+		models.ExtractAwsmTable(index, event, &header, &rows)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(header)
+	table.AppendBulk(rows)
+	table.Render()
+}
@@ -0,0 +1,390 @@
+package aws
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/murdinc/awsm/models"
+	"github.com/murdinc/terminal"
+	"github.com/olekukonko/tablewriter"
+)
+
+// MetricSeriesList represents a slice of CloudWatch metric series
+type MetricSeriesList []MetricSeries
+
+// MetricSeries represents the CloudWatch statistics of a single metric for a single asset
+type MetricSeries models.MetricSeries
+
+// metricAsset is an asset that CloudWatch metrics can be queried for
+type metricAsset struct {
+	assetType string
+	name      string
+	id        string
+	region    string
+	namespace string
+	dimension string
+	metric    string // default metric
+}
+
+// sparkTicks are the characters used to draw sparklines, from lowest to highest
+var sparkTicks = []rune("▁▂▃▄▅▆▇█")
+
+// GetMetrics returns the CloudWatch statistics of a metric for the instances, autoscale groups, volumes or load balancers matching the provided search
+func GetMetrics(search, assetType, metric, statistic string, period int, since string) (MetricSeriesList, error) {
+	var seriesList MetricSeriesList
+
+	if statistic == "" {
+		statistic = "Average"
+	}
+
+	switch statistic {
+	case "Average", "Sum", "Minimum", "Maximum", "SampleCount":
+	default:
+		return seriesList, errors.New("Statistic [" + statistic + "] is invalid! Must be one of: Average, Sum, Minimum, Maximum, SampleCount")
+	}
+
+	if period == 0 {
+		period = 300
+	}
+	if period < 60 || period%60 != 0 {
+		return seriesList, errors.New("Period must be a multiple of 60 seconds!")
+	}
+
+	if since == "" {
+		since = "3h"
+	}
+	duration, err := time.ParseDuration(since)
+	if err != nil {
+		return seriesList, errors.New("Unable to parse since duration [" + since + "], use a duration like 3h or 45m!")
+	}
+
+	assets, err := getMetricAssets(search, assetType)
+	if err != nil {
+		return seriesList, err
+	}
+
+	if len(assets) == 0 {
+		return seriesList, errors.New("No instances, autoscale groups, volumes or load balancers found matching [" + search + "]!")
+	}
+
+	// Group the assets by region
+	regionAssets := make(map[string][]metricAsset)
+	for _, asset := range assets {
+		regionAssets[asset.region] = append(regionAssets[asset.region], asset)
+	}
+
+	endTime := time.Now()
+	startTime := endTime.Add(-duration)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []string
+
+	for region, assets := range regionAssets {
+		wg.Add(1)
+
+		go func(region string, assets []metricAsset) {
+			defer wg.Done()
+
+			sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+			svc := cloudwatch.New(sess)
+
+			for _, asset := range assets {
+				assetMetric := metric
+				if assetMetric == "" {
+					assetMetric = asset.metric
+				}
+
+				series, err := getMetricSeries(svc, asset, assetMetric, statistic, period, startTime, endTime)
+
+				mu.Lock()
+				if err != nil {
+					errs = append(errs, fmt.Sprintf("[%s] in [%s]: %s", asset.name, region, err.Error()))
+				} else {
+					seriesList = append(seriesList, series)
+				}
+				mu.Unlock()
+			}
+		}(region, assets)
+	}
+	wg.Wait()
+
+	sort.Sort(seriesList)
+
+	if len(errs) > 0 {
+		return seriesList, errors.New("Error gathering metrics for " + strings.Join(errs, ", "))
+	}
+
+	return seriesList, nil
+}
+
+// getMetricAssets resolves the instances, autoscale groups, volumes and load balancers matching the provided search, optionally limited to one asset type
+func getMetricAssets(search, assetType string) ([]metricAsset, error) {
+	var assets []metricAsset
+
+	switch assetType {
+	case "", "instances", "autoscalegroups", "volumes", "loadbalancers":
+	default:
+		return assets, errors.New("Asset type [" + assetType + "] is invalid! Must be one of: instances, autoscalegroups, volumes, loadbalancers")
+	}
+
+	if assetType == "" || assetType == "instances" {
+		instances, _ := GetInstances(search, false)
+		for _, instance := range *instances {
+			assets = append(assets, metricAsset{assetType: "instances", name: instance.Name, id: instance.InstanceID, region: instance.Region, namespace: "AWS/EC2", dimension: "InstanceId", metric: "CPUUtilization"})
+		}
+	}
+
+	if assetType == "" || assetType == "autoscalegroups" {
+		groups, _ := GetAutoScaleGroups(search)
+		for _, group := range *groups {
+			assets = append(assets, metricAsset{assetType: "autoscalegroups", name: group.Name, id: group.Name, region: group.Region, namespace: "AWS/EC2", dimension: "AutoScalingGroupName", metric: "CPUUtilization"})
+		}
+	}
+
+	if assetType == "" || assetType == "volumes" {
+		volumes, _ := GetVolumes(search, false)
+		for _, volume := range *volumes {
+			assets = append(assets, metricAsset{assetType: "volumes", name: volume.Name, id: volume.VolumeID, region: volume.Region, namespace: "AWS/EBS", dimension: "VolumeId", metric: "VolumeQueueLength"})
+		}
+	}
+
+	if assetType == "" || assetType == "loadbalancers" {
+		loadBalancers, _ := GetLoadBalancers(search)
+		for _, lb := range *loadBalancers {
+			assets = append(assets, metricAsset{assetType: "loadbalancers", name: lb.Name, id: lb.Name, region: lb.Region, namespace: "AWS/ELB", dimension: "LoadBalancerName", metric: "RequestCount"})
+		}
+	}
+
+	return assets, nil
+}
+
+// private function to get the statistics of a single metric for a single asset
+func getMetricSeries(svc *cloudwatch.CloudWatch, asset metricAsset, metric, statistic string, period int, startTime, endTime time.Time) (MetricSeries, error) {
+
+	series := MetricSeries{
+		AssetType: asset.assetType,
+		AssetName: asset.name,
+		AssetID:   asset.id,
+		Namespace: asset.namespace,
+		Metric:    metric,
+		Statistic: statistic,
+		Period:    period,
+		Region:    asset.region,
+	}
+
+	params := &cloudwatch.GetMetricStatisticsInput{
+		Namespace:  aws.String(asset.namespace),
+		MetricName: aws.String(metric),
+		Dimensions: []*cloudwatch.Dimension{
+			{
+				Name:  aws.String(asset.dimension),
+				Value: aws.String(asset.id),
+			},
+		},
+		StartTime:  aws.Time(startTime),
+		EndTime:    aws.Time(endTime),
+		Period:     aws.Int64(int64(period)),
+		Statistics: []*string{aws.String(statistic)},
+	}
+
+	resp, err := svc.GetMetricStatistics(params)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return series, errors.New(awsErr.Message())
+		}
+		return series, err
+	}
+
+	for _, datapoint := range resp.Datapoints {
+		var value float64
+		switch statistic {
+		case "Average":
+			value = aws.Float64Value(datapoint.Average)
+		case "Sum":
+			value = aws.Float64Value(datapoint.Sum)
+		case "Minimum":
+			value = aws.Float64Value(datapoint.Minimum)
+		case "Maximum":
+			value = aws.Float64Value(datapoint.Maximum)
+		case "SampleCount":
+			value = aws.Float64Value(datapoint.SampleCount)
+		}
+
+		series.Unit = aws.StringValue(datapoint.Unit)
+		series.Datapoints = append(series.Datapoints, models.MetricDatapoint{
+			Timestamp: aws.TimeValue(datapoint.Timestamp),
+			Value:     value,
+		})
+	}
+
+	sort.Slice(series.Datapoints, func(i, j int) bool {
+		return series.Datapoints[i].Timestamp.Before(series.Datapoints[j].Timestamp)
+	})
+
+	return series, nil
+}
+
+// PrintMetrics prints the CloudWatch statistics of a metric for the assets matching the provided search as a table, sparklines or json
+func PrintMetrics(search, assetType, metric, statistic string, period int, since, output string) error {
+
+	if output == "" {
+		output = "table"
+	}
+
+	switch output {
+	case "table", "sparkline", "json":
+	default:
+		return errors.New("Output [" + output + "] is invalid! Must be one of: table, sparkline, json")
+	}
+
+	seriesList, err := GetMetrics(search, assetType, metric, statistic, period, since)
+	if err != nil && len(seriesList) == 0 {
+		return err
+	}
+	if err != nil {
+		terminal.ErrorLine(err.Error())
+	}
+
+	switch output {
+	case "json":
+		out, err := json.MarshalIndent(seriesList, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+
+	case "sparkline":
+		seriesList.PrintSparklines()
+
+	default:
+		seriesList.PrintTable()
+	}
+
+	return nil
+}
+
+// Len returns the number of metric series
+func (m MetricSeriesList) Len() int {
+	return len(m)
+}
+
+// Swap swaps the position of two metric series
+func (m MetricSeriesList) Swap(i, j int) {
+	m[i], m[j] = m[j], m[i]
+}
+
+// Less returns true if the metric series at index i sorts before the one at index j
+func (m MetricSeriesList) Less(i, j int) bool {
+	if m[i].AssetType != m[j].AssetType {
+		return m[i].AssetType < m[j].AssetType
+	}
+	if m[i].AssetName != m[j].AssetName {
+		return m[i].AssetName < m[j].AssetName
+	}
+	return m[i].Region < m[j].Region
+}
+
+// PrintTable Prints an ascii table of the datapoints of each metric series
+func (m *MetricSeriesList) PrintTable() {
+	if len(*m) == 0 {
+		terminal.ShowErrorMessage("Warning", "No Metrics Found!")
+		return
+	}
+
+	for _, series := range *m {
+		terminal.Information(series.title())
+
+		rows := make([][]string, len(series.Datapoints))
+		for i, datapoint := range series.Datapoints {
+			rows[i] = []string{datapoint.Timestamp.Local().Format("2006-01-02 15:04"), formatMetricValue(datapoint.Value)}
+		}
+
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Time", series.Statistic + " " + series.Unit})
+		table.AppendBulk(rows)
+		table.Render()
+	}
+}
+
+// PrintSparklines prints a sparkline of the datapoints of each metric series
+func (m *MetricSeriesList) PrintSparklines() {
+	if len(*m) == 0 {
+		terminal.ShowErrorMessage("Warning", "No Metrics Found!")
+		return
+	}
+
+	rows := make([][]string, len(*m))
+	for i, series := range *m {
+		values := make([]float64, len(series.Datapoints))
+		for k, datapoint := range series.Datapoints {
+			values[k] = datapoint.Value
+		}
+
+		min, max, latest := "", "", ""
+		if len(values) > 0 {
+			low, high := values[0], values[0]
+			for _, value := range values {
+				low = math.Min(low, value)
+				high = math.Max(high, value)
+			}
+			min, max, latest = formatMetricValue(low), formatMetricValue(high), formatMetricValue(values[len(values)-1])
+		}
+
+		rows[i] = []string{series.AssetName, series.AssetID, series.Region, series.Metric, sparkline(values), min, max, latest, series.Unit}
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Name", "ID", "Region", "Metric", "Sparkline", "Min", "Max", "Latest", "Unit"})
+	table.AppendBulk(rows)
+	table.Render()
+}
+
+// title returns a heading describing a metric series
+func (s MetricSeries) title() string {
+	name := s.AssetID
+	if s.AssetName != "" && s.AssetName != s.AssetID {
+		name = s.AssetName + " (" + s.AssetID + ")"
+	}
+	return fmt.Sprintf("%s %s of [%s] in [%s], every %ds", s.Statistic, s.Metric, name, s.Region, s.Period)
+}
+
+// sparkline draws a unicode sparkline of the provided values
+func sparkline(values []float64) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	low, high := values[0], values[0]
+	for _, value := range values {
+		low = math.Min(low, value)
+		high = math.Max(high, value)
+	}
+
+	line := make([]rune, len(values))
+	for i, value := range values {
+		tick := 0
+		if high > low {
+			tick = int((value - low) / (high - low) * float64(len(sparkTicks)-1))
+		}
+		line[i] = sparkTicks[tick]
+	}
+
+	return string(line)
+}
+
+// formatMetricValue formats a metric value without trailing zeros
+func formatMetricValue(value float64) string {
+	return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.2f", value), "0"), ".")
+}
@@ -0,0 +1,776 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/mitchellh/hashstructure"
+	"github.com/murdinc/awsm/aws/regions"
+	"github.com/murdinc/awsm/config"
+	"github.com/murdinc/awsm/models"
+	"github.com/murdinc/terminal"
+	"github.com/olekukonko/tablewriter"
+)
+
+// NetworkAcls represents a slice of Network ACLs
+type NetworkAcls []NetworkAcl
+
+// NetworkAcl represents a single Network ACL
+type NetworkAcl models.NetworkAcl
+
+// GetNetworkAcls returns a slice of Network ACLs that match the provided search term
+func GetNetworkAcls(search string) (*NetworkAcls, []error) {
+	var wg sync.WaitGroup
+	var errs []error
+
+	aclList := new(NetworkAcls)
+	regions := GetRegionListWithoutIgnored()
+
+	for _, region := range regions {
+		wg.Add(1)
+
+		go func(region *ec2.Region) {
+			defer wg.Done()
+			err := GetRegionNetworkAcls(*region.RegionName, aclList, search)
+			if err != nil {
+				terminal.ShowErrorMessage(fmt.Sprintf("Error gathering network acl list for region [%s]", *region.RegionName), err.Error())
+				errs = append(errs, err)
+			}
+		}(region)
+	}
+	wg.Wait()
+
+	return aclList, errs
+}
+
+// GetRegionNetworkAcls returns a list of a regions Network ACLs into the provided NetworkAcls slice
+func GetRegionNetworkAcls(region string, aclList *NetworkAcls, search string) error {
+
+	// Validate the region
+	if !regions.ValidRegion(region) {
+		return errors.New("Region [" + region + "] is Invalid!")
+	}
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := ec2.New(sess)
+
+	result, err := svc.DescribeNetworkAcls(&ec2.DescribeNetworkAclsInput{})
+	if err != nil {
+		return err
+	}
+
+	vpcList := new(Vpcs)
+	GetRegionVpcs(region, vpcList, "")
+
+	acls := make(NetworkAcls, len(result.NetworkAcls))
+	for i, acl := range result.NetworkAcls {
+		acls[i].Marshal(acl, region, vpcList)
+	}
+
+	if search != "" {
+		term := regexp.MustCompile(search)
+	Loop:
+		for i, a := range acls {
+			rAcl := reflect.ValueOf(a)
+
+			for k := 0; k < rAcl.NumField(); k++ {
+				sVal := rAcl.Field(k).String()
+
+				if term.MatchString(sVal) {
+					*aclList = append(*aclList, acls[i])
+					continue Loop
+				}
+			}
+		}
+	} else {
+		*aclList = append(*aclList, acls[:]...)
+	}
+
+	return nil
+}
+
+// GetVpcNetworkAclsByTag returns a slice of a VPCs Network ACLs that match the provided Tag key/value
+func (v *Vpc) GetVpcNetworkAclsByTag(key, value string) (NetworkAcls, error) {
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(v.Region)}))
+	svc := ec2.New(sess)
+
+	params := &ec2.DescribeNetworkAclsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name: aws.String("vpc-id"),
+				Values: []*string{
+					aws.String(v.VpcID),
+				},
+			},
+			{
+				Name: aws.String("tag:" + key),
+				Values: []*string{
+					aws.String(value),
+				},
+			},
+		},
+	}
+
+	result, err := svc.DescribeNetworkAcls(params)
+	if err != nil {
+		return NetworkAcls{}, err
+	}
+
+	acls := make(NetworkAcls, len(result.NetworkAcls))
+	for i, acl := range result.NetworkAcls {
+		acls[i].Marshal(acl, v.Region, &Vpcs{*v})
+	}
+
+	return acls, nil
+}
+
+// Marshal parses the response from the aws sdk into an awsm Network ACL
+func (n *NetworkAcl) Marshal(acl *ec2.NetworkAcl, region string, vpcList *Vpcs) {
+	n.Name = GetTagValue("Name", acl.Tags)
+	n.Class = GetTagValue("Class", acl.Tags)
+	n.NetworkAclID = aws.StringValue(acl.NetworkAclId)
+	n.VpcID = aws.StringValue(acl.VpcId)
+	n.VpcName = vpcList.GetVpcName(n.VpcID)
+	n.Default = aws.BoolValue(acl.IsDefault)
+	n.Region = region
+
+	for _, association := range acl.Associations {
+		n.Subnets = append(n.Subnets, aws.StringValue(association.SubnetId))
+		n.Associations = append(n.Associations, models.NetworkAclAssociation{
+			AssociationID: aws.StringValue(association.NetworkAclAssociationId),
+			SubnetID:      aws.StringValue(association.SubnetId),
+		})
+	}
+
+	for _, entry := range acl.Entries {
+
+		// Skip the IPv4 and IPv6 catch-all rules, they can't be modified or removed
+		if ruleNumber := aws.Int64Value(entry.RuleNumber); ruleNumber == 32767 || ruleNumber == 32768 {
+			continue
+		}
+
+		aclEntry := config.NetworkAclEntry{
+			Type:       "ingress",
+			RuleNumber: int(aws.Int64Value(entry.RuleNumber)),
+			RuleAction: aws.StringValue(entry.RuleAction),
+			Protocol:   aws.StringValue(entry.Protocol),
+			CidrBlock:  aws.StringValue(entry.CidrBlock),
+		}
+
+		if aws.BoolValue(entry.Egress) {
+			aclEntry.Type = "egress"
+		}
+
+		if entry.Ipv6CidrBlock != nil {
+			aclEntry.CidrBlock = aws.StringValue(entry.Ipv6CidrBlock)
+		}
+
+		if entry.PortRange != nil {
+			aclEntry.FromPort = int(aws.Int64Value(entry.PortRange.From))
+			aclEntry.ToPort = int(aws.Int64Value(entry.PortRange.To))
+		}
+
+		n.NetworkAclEntries = append(n.NetworkAclEntries, normalizeNetworkAclEntry(aclEntry))
+	}
+}
+
+// PrintTable Prints an ascii table of the list of Network ACLs
+func (n *NetworkAcls) PrintTable() {
+	if len(*n) == 0 {
+		terminal.ShowErrorMessage("Warning", "No Network ACLs Found!")
+		return
+	}
+
+	var header []string
+	rows := make([][]string, len(*n))
+
+	for index, acl := range *n {
+		models.ExtractAwsmTable(index, acl, &header, &rows)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(header)
+	table.AppendBulk(rows)
+	table.Render()
+}
+
+// CreateNetworkAcl creates a new Network ACL of the provided class in a VPC
+func CreateNetworkAcl(class, name, vpcSearch string, dryRun bool) error {
+
+	// --dry-run flag
+	if dryRun {
+		terminal.Information("--dry-run flag is set, not making any actual changes!")
+	}
+
+	// Verify the network acl class input
+	_, err := config.LoadNetworkAclClass(class)
+	if err != nil {
+		return err
+	}
+
+	terminal.Information("Found Network ACL Class Configuration for [" + class + "]!")
+
+	// Verify the VPC input
+	vpcs, _ := GetVpcs(vpcSearch)
+	vpcCount := len(*vpcs)
+	if vpcCount == 0 {
+		return errors.New("No VPCs found for your search terms.")
+	}
+	if vpcCount > 1 {
+		vpcs.PrintTable()
+		return errors.New("Please limit your search to return only one VPC.")
+	}
+	vpc := (*vpcs)[0]
+
+	terminal.Information("Found VPC [" + vpc.VpcID + "] named [" + vpc.Name + "] with a class of [" + vpc.Class + "] in [" + vpc.Region + "]!")
+
+	_, err = createNetworkAcl(class, name, vpc, dryRun)
+	if err != nil {
+		return err
+	}
+
+	terminal.Information("Done!")
+
+	return nil
+}
+
+// private function without terminal prompts
+func createNetworkAcl(class, name string, vpc Vpc, dryRun bool) (string, error) {
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(vpc.Region)}))
+	svc := ec2.New(sess)
+
+	params := &ec2.CreateNetworkAclInput{
+		VpcId:  aws.String(vpc.VpcID),
+		DryRun: aws.Bool(dryRun),
+	}
+
+	createAclResp, err := svc.CreateNetworkAcl(params)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return "", errors.New(awsErr.Message())
+		}
+		return "", err
+	}
+
+	aclId := aws.StringValue(createAclResp.NetworkAcl.NetworkAclId)
+	terminal.Delta("Created Network ACL [" + aclId + "] named [" + name + "] in [" + vpc.Region + "]!")
+
+	// Tag it
+	err = SetEc2NameAndClassTags(&aclId, name, class, vpc.Region)
+	if err != nil {
+		return aclId, err
+	}
+
+	// Add Entries
+	acl := NetworkAcl{
+		Name:         name,
+		Class:        class,
+		NetworkAclID: aclId,
+		VpcID:        vpc.VpcID,
+		VpcName:      vpc.Name,
+		Region:       vpc.Region,
+	}
+
+	changes, err := NetworkAcls{acl}.Diff()
+	if err != nil {
+		return aclId, err
+	}
+
+	return aclId, updateNetworkAcls(changes, dryRun)
+}
+
+// associateNetworkAcl replaces the current Network ACL association of a Subnet
+func associateNetworkAcl(aclId, subnetId, region string, dryRun bool) error {
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := ec2.New(sess)
+
+	// Every subnet is associated with a network acl, find the current association
+	result, err := svc.DescribeNetworkAcls(&ec2.DescribeNetworkAclsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name: aws.String("association.subnet-id"),
+				Values: []*string{
+					aws.String(subnetId),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	associationId := ""
+
+Loop:
+	for _, acl := range result.NetworkAcls {
+		for _, association := range acl.Associations {
+			if aws.StringValue(association.SubnetId) == subnetId {
+				associationId = aws.StringValue(association.NetworkAclAssociationId)
+				break Loop
+			}
+		}
+	}
+
+	if associationId == "" {
+		return errors.New("Unable to locate the network acl association of Subnet [" + subnetId + "]!")
+	}
+
+	params := &ec2.ReplaceNetworkAclAssociationInput{
+		AssociationId: aws.String(associationId),
+		NetworkAclId:  aws.String(aclId),
+		DryRun:        aws.Bool(dryRun),
+	}
+
+	_, err = svc.ReplaceNetworkAclAssociation(params)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == "DryRunOperation" {
+				return nil
+			}
+			return errors.New(awsErr.Message())
+		}
+		return err
+	}
+
+	terminal.Delta("Associated Network ACL [" + aclId + "] to Subnet [" + subnetId + "]!")
+
+	return nil
+}
+
+// UpdateNetworkAcls updates one or more Network ACLs that match the provided search term and optional region
+func UpdateNetworkAcls(search, region string, dryRun bool) (err error) {
+
+	// --dry-run flag
+	if dryRun {
+		terminal.Information("--dry-run flag is set, not making any actual changes!")
+	}
+
+	aclList := new(NetworkAcls)
+
+	// Check if we were given a region or not
+	if region != "" {
+		err = GetRegionNetworkAcls(region, aclList, search)
+	} else {
+		aclList, _ = GetNetworkAcls(search)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if len(*aclList) > 0 {
+		// Print the table
+		aclList.PrintTable()
+	} else {
+		return errors.New("No Network ACLs found, Aborting!")
+	}
+
+	changes, err := aclList.Diff()
+	if err != nil {
+		return err
+	}
+
+	if len(changes) == 0 {
+		terminal.Information("There are no changes needed on these Network ACLs!")
+		return nil
+	}
+
+	// Confirm
+	if !terminal.PromptBool("Are you sure you want to update these Network ACLs?") {
+		return errors.New("Aborting!")
+	}
+
+	// Update 'Em
+	err = updateNetworkAcls(changes, dryRun)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return errors.New(awsErr.Message())
+		}
+		return err
+	}
+
+	return nil
+}
+
+// NetworkAclChanges represents a slice of Network ACL Changes
+type NetworkAclChanges []NetworkAclChange
+
+// NetworkAclChange represents a set of entries to create, replace or delete on a single Network ACL
+type NetworkAclChange struct {
+	NetworkAcl NetworkAcl
+	Action     string // create / replace / delete
+	Entries    []config.NetworkAclEntry
+}
+
+// Diff compares Network ACLs with their classes and returns the changes needed, deletes first
+func (n NetworkAcls) Diff() (NetworkAclChanges, error) {
+
+	terminal.Delta("Comparing awsm Network ACL entries...")
+
+	changes := NetworkAclChanges{}
+
+	for _, acl := range n {
+
+		// Verify the network acl class input
+		cfg, err := config.LoadNetworkAclClass(acl.Class)
+		if err != nil {
+			return changes, err
+		}
+
+		// cycle through the config entries and generate hashes
+		cfgHashes := make(map[uint64]config.NetworkAclEntry)
+		for _, cEntry := range cfg.NetworkAclEntries {
+			entry := normalizeNetworkAclEntry(cEntry)
+
+			configEntryHash, err := hashstructure.Hash(entry, nil)
+			if err != nil {
+				return changes, err
+			}
+			cfgHashes[configEntryHash] = entry
+		}
+
+		var deleteEntries, replaceEntries, createEntries []config.NetworkAclEntry
+
+		// cycle through existing entries and find ones to remove
+		existingRules := make(map[string]config.NetworkAclEntry)
+		for _, entry := range acl.NetworkAclEntries {
+			existingEntryHash, err := hashstructure.Hash(entry, nil)
+			if err != nil {
+				return changes, err
+			}
+
+			if _, ok := cfgHashes[existingEntryHash]; ok {
+				delete(cfgHashes, existingEntryHash)
+			} else {
+				existingRules[fmt.Sprintf("%s/%d", entry.Type, entry.RuleNumber)] = entry
+			}
+		}
+
+		// cycle through the remaining config entries, rule numbers already in use are replaced in place
+		for _, entry := range cfgHashes {
+			ruleKey := fmt.Sprintf("%s/%d", entry.Type, entry.RuleNumber)
+			if _, ok := existingRules[ruleKey]; ok {
+				terminal.Delta(fmt.Sprintf("[%s %s] - Replace - [%s #%d]	[%s %s :%d-%d]	[%s]", acl.Name, acl.Region, entry.Type, entry.RuleNumber, entry.RuleAction, entry.Protocol, entry.FromPort, entry.ToPort, entry.CidrBlock))
+				replaceEntries = append(replaceEntries, entry)
+				delete(existingRules, ruleKey)
+			} else {
+				terminal.Delta(fmt.Sprintf("[%s %s] - Create - [%s #%d]	[%s %s :%d-%d]	[%s]", acl.Name, acl.Region, entry.Type, entry.RuleNumber, entry.RuleAction, entry.Protocol, entry.FromPort, entry.ToPort, entry.CidrBlock))
+				createEntries = append(createEntries, entry)
+			}
+		}
+
+		for _, entry := range existingRules {
+			terminal.Delta(fmt.Sprintf("[%s %s] - Delete - [%s #%d]	[%s %s :%d-%d]	[%s]", acl.Name, acl.Region, entry.Type, entry.RuleNumber, entry.RuleAction, entry.Protocol, entry.FromPort, entry.ToPort, entry.CidrBlock))
+			deleteEntries = append(deleteEntries, entry)
+		}
+
+		// delete, replace, then create
+		if len(deleteEntries) > 0 {
+			changes = append(changes, NetworkAclChange{
+				NetworkAcl: acl,
+				Action:     "delete",
+				Entries:    sortNetworkAclEntries(deleteEntries),
+			})
+		}
+		if len(replaceEntries) > 0 {
+			changes = append(changes, NetworkAclChange{
+				NetworkAcl: acl,
+				Action:     "replace",
+				Entries:    sortNetworkAclEntries(replaceEntries),
+			})
+		}
+		if len(createEntries) > 0 {
+			changes = append(changes, NetworkAclChange{
+				NetworkAcl: acl,
+				Action:     "create",
+				Entries:    sortNetworkAclEntries(createEntries),
+			})
+		}
+	}
+
+	terminal.Information("Comparison complete!")
+
+	return changes, nil
+}
+
+// sortNetworkAclEntries sorts Network ACL entries by direction and rule number
+func sortNetworkAclEntries(entries []config.NetworkAclEntry) []config.NetworkAclEntry {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Type != entries[j].Type {
+			return entries[i].Type == "ingress"
+		}
+		return entries[i].RuleNumber < entries[j].RuleNumber
+	})
+	return entries
+}
+
+// normalizeNetworkAclEntry converts a Network ACL entry into the form the aws sdk returns, so that they can be compared
+func normalizeNetworkAclEntry(entry config.NetworkAclEntry) config.NetworkAclEntry {
+
+	switch strings.ToLower(entry.Protocol) {
+	case "all", "":
+		entry.Protocol = "-1"
+	case "icmp":
+		entry.Protocol = "1"
+	case "tcp":
+		entry.Protocol = "6"
+	case "udp":
+		entry.Protocol = "17"
+	case "icmpv6":
+		entry.Protocol = "58"
+	}
+
+	entry.RuleAction = strings.ToLower(entry.RuleAction)
+
+	// Ports only apply to tcp and udp
+	if entry.Protocol != "6" && entry.Protocol != "17" {
+		entry.FromPort = 0
+		entry.ToPort = 0
+	}
+
+	return entry
+}
+
+// private function without terminal prompts
+func updateNetworkAcls(changes NetworkAclChanges, dryRun bool) error {
+
+	for _, change := range changes {
+		var err error
+
+		switch change.Action {
+		case "delete":
+			err = deleteNetworkAclEntries(change.NetworkAcl, change.Entries, dryRun)
+		case "replace":
+			err = replaceNetworkAclEntries(change.NetworkAcl, change.Entries, dryRun)
+		case "create":
+			err = createNetworkAclEntries(change.NetworkAcl, change.Entries, dryRun)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	terminal.Information("Done!")
+
+	return nil
+}
+
+func createNetworkAclEntries(acl NetworkAcl, entries []config.NetworkAclEntry, dryRun bool) error {
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(acl.Region)}))
+	svc := ec2.New(sess)
+
+	for _, entry := range entries {
+		params := &ec2.CreateNetworkAclEntryInput{
+			NetworkAclId: aws.String(acl.NetworkAclID),
+			Egress:       aws.Bool(entry.Type == "egress"),
+			RuleNumber:   aws.Int64(int64(entry.RuleNumber)),
+			RuleAction:   aws.String(entry.RuleAction),
+			Protocol:     aws.String(entry.Protocol),
+			DryRun:       aws.Bool(dryRun),
+		}
+
+		if strings.Contains(entry.CidrBlock, ":") {
+			params.SetIpv6CidrBlock(entry.CidrBlock)
+		} else {
+			params.SetCidrBlock(entry.CidrBlock)
+		}
+
+		switch entry.Protocol {
+		case "6", "17":
+			params.SetPortRange(&ec2.PortRange{
+				From: aws.Int64(int64(entry.FromPort)),
+				To:   aws.Int64(int64(entry.ToPort)),
+			})
+		case "1", "58":
+			params.SetIcmpTypeCode(&ec2.IcmpTypeCode{
+				Type: aws.Int64(-1),
+				Code: aws.Int64(-1),
+			})
+		}
+
+		_, err := svc.CreateNetworkAclEntry(params)
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok {
+				if awsErr.Code() == "DryRunOperation" {
+					continue
+				}
+				return errors.New(awsErr.Message())
+			}
+			return err
+		}
+
+		terminal.Delta(fmt.Sprintf("Created [%s #%d] entry on Network ACL [%s] in [%s]!", entry.Type, entry.RuleNumber, acl.NetworkAclID, acl.Region))
+	}
+
+	return nil
+}
+
+func replaceNetworkAclEntries(acl NetworkAcl, entries []config.NetworkAclEntry, dryRun bool) error {
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(acl.Region)}))
+	svc := ec2.New(sess)
+
+	for _, entry := range entries {
+		params := &ec2.ReplaceNetworkAclEntryInput{
+			NetworkAclId: aws.String(acl.NetworkAclID),
+			Egress:       aws.Bool(entry.Type == "egress"),
+			RuleNumber:   aws.Int64(int64(entry.RuleNumber)),
+			RuleAction:   aws.String(entry.RuleAction),
+			Protocol:     aws.String(entry.Protocol),
+			DryRun:       aws.Bool(dryRun),
+		}
+
+		if strings.Contains(entry.CidrBlock, ":") {
+			params.SetIpv6CidrBlock(entry.CidrBlock)
+		} else {
+			params.SetCidrBlock(entry.CidrBlock)
+		}
+
+		switch entry.Protocol {
+		case "6", "17":
+			params.SetPortRange(&ec2.PortRange{
+				From: aws.Int64(int64(entry.FromPort)),
+				To:   aws.Int64(int64(entry.ToPort)),
+			})
+		case "1", "58":
+			params.SetIcmpTypeCode(&ec2.IcmpTypeCode{
+				Type: aws.Int64(-1),
+				Code: aws.Int64(-1),
+			})
+		}
+
+		_, err := svc.ReplaceNetworkAclEntry(params)
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok {
+				if awsErr.Code() == "DryRunOperation" {
+					continue
+				}
+				return errors.New(awsErr.Message())
+			}
+			return err
+		}
+
+		terminal.Delta(fmt.Sprintf("Replaced [%s #%d] entry on Network ACL [%s] in [%s]!", entry.Type, entry.RuleNumber, acl.NetworkAclID, acl.Region))
+	}
+
+	return nil
+}
+
+func deleteNetworkAclEntries(acl NetworkAcl, entries []config.NetworkAclEntry, dryRun bool) error {
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(acl.Region)}))
+	svc := ec2.New(sess)
+
+	for _, entry := range entries {
+		params := &ec2.DeleteNetworkAclEntryInput{
+			NetworkAclId: aws.String(acl.NetworkAclID),
+			Egress:       aws.Bool(entry.Type == "egress"),
+			RuleNumber:   aws.Int64(int64(entry.RuleNumber)),
+			DryRun:       aws.Bool(dryRun),
+		}
+
+		_, err := svc.DeleteNetworkAclEntry(params)
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok {
+				if awsErr.Code() == "DryRunOperation" {
+					continue
+				}
+				return errors.New(awsErr.Message())
+			}
+			return err
+		}
+
+		terminal.Delta(fmt.Sprintf("Deleted [%s #%d] entry from Network ACL [%s] in [%s]!", entry.Type, entry.RuleNumber, acl.NetworkAclID, acl.Region))
+	}
+
+	return nil
+}
+
+// DeleteNetworkAcls deletes one or more Network ACLs that match the provided search term and optional region
+func DeleteNetworkAcls(search, region string, dryRun bool) (err error) {
+
+	// --dry-run flag
+	if dryRun {
+		terminal.Information("--dry-run flag is set, not making any actual changes!")
+	}
+
+	aclList := new(NetworkAcls)
+
+	// Check if we were given a region or not
+	if region != "" {
+		err = GetRegionNetworkAcls(region, aclList, search)
+	} else {
+		aclList, _ = GetNetworkAcls(search)
+	}
+
+	if err != nil {
+		return errors.New("Error gathering Network ACL list")
+	}
+
+	if len(*aclList) > 0 {
+		// Print the table
+		aclList.PrintTable()
+	} else {
+		return errors.New("No Network ACLs found, Aborting!")
+	}
+
+	// Confirm
+	if !terminal.PromptBool("Are you sure you want to delete these Network ACLs?") {
+		return errors.New("Aborting!")
+	}
+
+	// Delete 'Em
+	err = deleteNetworkAcls(aclList, dryRun)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return errors.New(awsErr.Message())
+		}
+		return err
+	}
+
+	terminal.Information("Done!")
+
+	return nil
+}
+
+// private function without the confirmation terminal prompts
+func deleteNetworkAcls(aclList *NetworkAcls, dryRun bool) (err error) {
+	for _, acl := range *aclList {
+
+		if acl.Default {
+			terminal.Notice("Skipping default Network ACL [" + acl.NetworkAclID + "] in [" + acl.Region + "], it can only be deleted along with its VPC")
+			continue
+		}
+
+		sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(acl.Region)}))
+		svc := ec2.New(sess)
+
+		params := &ec2.DeleteNetworkAclInput{
+			NetworkAclId: aws.String(acl.NetworkAclID),
+			DryRun:       aws.Bool(dryRun),
+		}
+
+		_, err := svc.DeleteNetworkAcl(params)
+		if err != nil {
+			return err
+		}
+
+		terminal.Delta("Deleted Network ACL [" + acl.Name + "] in [" + acl.Region + "]!")
+	}
+
+	return nil
+}
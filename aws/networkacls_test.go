@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/murdinc/awsm/config"
+)
+
+func TestNormalizeNetworkAclEntry(t *testing.T) {
+	tests := []struct {
+		name  string
+		entry config.NetworkAclEntry
+		want  config.NetworkAclEntry
+	}{
+		{
+			name:  "tcp keeps its ports",
+			entry: config.NetworkAclEntry{Protocol: "TCP", RuleAction: "ALLOW", FromPort: 80, ToPort: 443},
+			want:  config.NetworkAclEntry{Protocol: "6", RuleAction: "allow", FromPort: 80, ToPort: 443},
+		},
+		{
+			name:  "udp keeps its ports",
+			entry: config.NetworkAclEntry{Protocol: "udp", RuleAction: "deny", FromPort: 53, ToPort: 53},
+			want:  config.NetworkAclEntry{Protocol: "17", RuleAction: "deny", FromPort: 53, ToPort: 53},
+		},
+		{
+			name:  "all drops its ports",
+			entry: config.NetworkAclEntry{Protocol: "all", RuleAction: "allow", FromPort: 1, ToPort: 65535},
+			want:  config.NetworkAclEntry{Protocol: "-1", RuleAction: "allow"},
+		},
+		{
+			name:  "empty protocol is all",
+			entry: config.NetworkAclEntry{RuleAction: "Allow"},
+			want:  config.NetworkAclEntry{Protocol: "-1", RuleAction: "allow"},
+		},
+		{
+			name:  "icmp drops its ports",
+			entry: config.NetworkAclEntry{Protocol: "icmp", RuleAction: "allow", FromPort: 8, ToPort: 8},
+			want:  config.NetworkAclEntry{Protocol: "1", RuleAction: "allow"},
+		},
+		{
+			name:  "icmpv6",
+			entry: config.NetworkAclEntry{Protocol: "icmpv6", RuleAction: "allow"},
+			want:  config.NetworkAclEntry{Protocol: "58", RuleAction: "allow"},
+		},
+		{
+			name:  "protocol numbers are kept",
+			entry: config.NetworkAclEntry{Protocol: "6", RuleAction: "allow", FromPort: 22, ToPort: 22},
+			want:  config.NetworkAclEntry{Protocol: "6", RuleAction: "allow", FromPort: 22, ToPort: 22},
+		},
+	}
+
+	for _, test := range tests {
+		got := normalizeNetworkAclEntry(test.entry)
+		if got != test.want {
+			t.Errorf("%s: normalizeNetworkAclEntry(%+v) = %+v, want %+v", test.name, test.entry, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,325 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/hil/ast"
+	"github.com/murdinc/awsm/aws/regions"
+	"github.com/murdinc/awsm/models"
+	"github.com/murdinc/terminal"
+	"github.com/olekukonko/tablewriter"
+)
+
+// Parameters represents a slice of SSM Parameter Store parameters
+type Parameters []Parameter
+
+// Parameter represents a single SSM Parameter Store parameter
+type Parameter models.Parameter
+
+// GetParameters returns a slice of SSM Parameters that match the provided search term
+func GetParameters(search string) (*Parameters, []error) {
+	var wg sync.WaitGroup
+	var errs []error
+
+	paramList := new(Parameters)
+
+	for _, region := range ssmRegions {
+		wg.Add(1)
+
+		go func(region string) {
+			defer wg.Done()
+			err := GetRegionParameters(region, paramList, search)
+			if err != nil {
+				terminal.ShowErrorMessage(fmt.Sprintf("Error gathering parameter list for region [%s]", region), err.Error())
+				errs = append(errs, err)
+			}
+		}(region)
+	}
+	wg.Wait()
+
+	return paramList, errs
+}
+
+// GetRegionParameters returns a list of a regions SSM Parameters into the provided Parameters slice
+func GetRegionParameters(region string, paramList *Parameters, search string) error {
+
+	// Validate the region
+	if !regions.ValidRegion(region) {
+		return errors.New("Region [" + region + "] is Invalid!")
+	}
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := ssm.New(sess)
+
+	var params Parameters
+	err := svc.DescribeParametersPages(&ssm.DescribeParametersInput{}, func(page *ssm.DescribeParametersOutput, lastPage bool) bool {
+		for _, metadata := range page.Parameters {
+			param := Parameter{}
+			param.Marshal(metadata, region)
+			params = append(params, param)
+		}
+		return true
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return errors.New(awsErr.Message())
+		}
+		return err
+	}
+
+	if search != "" {
+		term := regexp.MustCompile(search)
+	Loop:
+		for i, p := range params {
+			rParam := reflect.ValueOf(p)
+
+			for k := 0; k < rParam.NumField(); k++ {
+				sVal := rParam.Field(k).String()
+
+				if term.MatchString(sVal) {
+					*paramList = append(*paramList, params[i])
+					continue Loop
+				}
+			}
+		}
+	} else {
+		*paramList = append(*paramList, params[:]...)
+	}
+
+	return nil
+}
+
+// Marshal parses the response from the aws sdk into an awsm Parameter
+func (p *Parameter) Marshal(metadata *ssm.ParameterMetadata, region string) {
+	p.Name = aws.StringValue(metadata.Name)
+	p.Type = aws.StringValue(metadata.Type)
+	p.Description = aws.StringValue(metadata.Description)
+	p.KeyID = aws.StringValue(metadata.KeyId)
+	p.Version = int(aws.Int64Value(metadata.Version))
+	p.LastModifiedDate = aws.TimeValue(metadata.LastModifiedDate)
+	p.LastModifiedUser = aws.StringValue(metadata.LastModifiedUser)
+	p.Region = region
+}
+
+// PrintTable Prints an ascii table of the list of SSM Parameters
+func (p *Parameters) PrintTable() {
+	if len(*p) == 0 {
+		terminal.ShowErrorMessage("Warning", "No Parameters Found!")
+		return
+	}
+
+	var header []string
+	rows := make([][]string, len(*p))
+
+	for index, param := range *p {
+		models.ExtractAwsmTable(index, param, &header, &rows)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(header)
+	table.AppendBulk(rows)
+	table.Render()
+}
+
+// PutParameter creates or updates an SSM Parameter in a region. A value of "-" reads the value from stdin, keeping it out of the shell history
+func PutParameter(name, value, region, paramType, keyId, description string, overwrite, dryRun bool) error {
+
+	// --dry-run flag
+	if dryRun {
+		terminal.Information("--dry-run flag is set, not making any actual changes!")
+	}
+
+	// Validate the region
+	if !regions.ValidRegion(region) {
+		return errors.New("Region [" + region + "] is Invalid!")
+	}
+
+	switch paramType {
+	case "String", "StringList", "SecureString":
+	default:
+		return errors.New("Parameter type [" + paramType + "] is invalid! Must be one of: String, StringList, SecureString")
+	}
+
+	if keyId != "" && paramType != "SecureString" {
+		return errors.New("A KMS Key can only be used with SecureString parameters!")
+	}
+
+	if value == "-" {
+		in, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		value = strings.TrimRight(string(in), "\n")
+	}
+
+	if value == "" {
+		return errors.New("Parameter values can not be empty!")
+	}
+
+	return putParameter(name, value, region, paramType, keyId, description, overwrite, dryRun)
+}
+
+// private function without terminal prompts
+func putParameter(name, value, region, paramType, keyId, description string, overwrite, dryRun bool) error {
+
+	if dryRun {
+		terminal.Notice("Would have put [" + paramType + "] Parameter [" + name + "] in [" + region + "]")
+		return nil
+	}
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := ssm.New(sess)
+
+	params := &ssm.PutParameterInput{
+		Name:      aws.String(name),
+		Value:     aws.String(value),
+		Type:      aws.String(paramType),
+		Overwrite: aws.Bool(overwrite),
+	}
+
+	if keyId != "" {
+		params.SetKeyId(keyId)
+	}
+	if description != "" {
+		params.SetDescription(description)
+	}
+
+	resp, err := svc.PutParameter(params)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == ssm.ErrCodeParameterAlreadyExists {
+				return errors.New("Parameter [" + name + "] already exists in [" + region + "], use the --overwrite flag to update it.")
+			}
+			return errors.New(awsErr.Message())
+		}
+		return err
+	}
+
+	terminal.Delta(fmt.Sprintf("Put [%s] Parameter [%s] version [%d] in [%s]!", paramType, name, aws.Int64Value(resp.Version), region))
+
+	return nil
+}
+
+// DeleteParameters deletes one or more SSM Parameters based on the search and optional region input
+func DeleteParameters(search, region string, dryRun bool) (err error) {
+
+	// --dry-run flag
+	if dryRun {
+		terminal.Information("--dry-run flag is set, not making any actual changes!")
+	}
+
+	paramList := new(Parameters)
+
+	// Check if we were given a region or not
+	if region != "" {
+		err = GetRegionParameters(region, paramList, search)
+	} else {
+		paramList, _ = GetParameters(search)
+	}
+
+	if err != nil {
+		return errors.New("Error gathering Parameter list")
+	}
+
+	if len(*paramList) > 0 {
+		// Print the table
+		paramList.PrintTable()
+	} else {
+		return errors.New("No Parameters found!")
+	}
+
+	// Confirm
+	if !terminal.PromptBool("Are you sure you want to delete these Parameters?") {
+		return errors.New("Aborting!")
+	}
+
+	// Delete 'Em
+	err = deleteParameters(paramList, dryRun)
+	if err != nil {
+		return err
+	}
+
+	terminal.Information("Done!")
+
+	return nil
+}
+
+// Private function without the confirmation terminal prompts
+func deleteParameters(paramList *Parameters, dryRun bool) (err error) {
+	if !dryRun {
+		for _, param := range *paramList {
+			sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(param.Region)}))
+			svc := ssm.New(sess)
+
+			_, err := svc.DeleteParameter(&ssm.DeleteParameterInput{
+				Name: aws.String(param.Name),
+			})
+			if err != nil {
+				if awsErr, ok := err.(awserr.Error); ok {
+					return errors.New(awsErr.Message())
+				}
+				return err
+			}
+
+			terminal.Delta("Deleted Parameter [" + param.Name + "] in [" + param.Region + "]!")
+		}
+	}
+
+	return nil
+}
+
+// getParameterValue returns the decrypted value and type of an SSM Parameter in a region
+func getParameterValue(name, region string) (value, paramType string, err error) {
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := ssm.New(sess)
+
+	resp, err := svc.GetParameter(&ssm.GetParameterInput{
+		Name:           aws.String(name),
+		WithDecryption: aws.Bool(true),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return "", "", errors.New("Unable to get Parameter [" + name + "] in [" + region + "]: " + awsErr.Message())
+		}
+		return "", "", err
+	}
+
+	return aws.StringValue(resp.Parameter.Value), aws.StringValue(resp.Parameter.Type), nil
+}
+
+// userDataFuncs returns the hil functions available to user data templates. ${ssm("/path/to/param")} resolves a
+// parameter in the launch region, SecureString values are masked during dry runs so they don't end up in the terminal.
+func userDataFuncs(region string, dryRun bool) map[string]ast.Function {
+	return map[string]ast.Function{
+		"ssm": {
+			ArgTypes:   []ast.Type{ast.TypeString},
+			ReturnType: ast.TypeString,
+			Callback: func(args []interface{}) (interface{}, error) {
+				name := args[0].(string)
+
+				value, paramType, err := getParameterValue(name, region)
+				if err != nil {
+					return "", err
+				}
+
+				if dryRun && paramType == "SecureString" {
+					return "[SecureString " + name + "]", nil
+				}
+
+				return value, nil
+			},
+		},
+	}
+}
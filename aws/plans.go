@@ -0,0 +1,496 @@
+package aws
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/murdinc/awsm/config"
+	"github.com/murdinc/terminal"
+	"github.com/olekukonko/tablewriter"
+)
+
+// Plan represents a set of changes that were worked out ahead of time, to be reviewed, approved by a second engineer and applied later.
+// Who wrote and approved a plan is recorded in the awsm database by the hash of the plan, never in the plan file itself.
+type Plan struct {
+	Command   string       `json:"command"`
+	CreatedBy string       `json:"createdBy"`
+	CreatedAt time.Time    `json:"createdAt"`
+	Changes   []PlanChange `json:"changes"`
+}
+
+// PlanChange represents a single change of a Plan, along with the state of the asset it was worked out against
+type PlanChange struct {
+	AssetType            string                `json:"assetType"`
+	Asset                string                `json:"asset"`
+	Region               string                `json:"region"`
+	State                string                `json:"state"`
+	SecurityGroupChange  *SecurityGroupChange  `json:"securityGroupChange,omitempty"`
+	LoadBalancerChange   *LoadBalancerChange   `json:"loadBalancerChange,omitempty"`
+	AutoScaleGroupChange *AutoScaleGroupChange `json:"autoScaleGroupChange,omitempty"`
+}
+
+// callerIdentity returns the ARN of the current AWS credentials
+func callerIdentity() (string, error) {
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String("us-east-1")}))
+	svc := sts.New(sess)
+
+	resp, err := svc.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return "", errors.New(awsErr.Message())
+		}
+		return "", err
+	}
+
+	return aws.StringValue(resp.Arn), nil
+}
+
+// planHash returns a hex sha256 hash of the json encoding of a value
+func planHash(v interface{}) (string, error) {
+	out, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(out)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// normalizeStrings returns a sorted copy of a list of strings
+func normalizeStrings(list []string) []string {
+	sorted := append([]string{}, list...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+// normalizeSecurityGroup sorts the grants of a Security Group, AWS does not return them in any particular order
+func normalizeSecurityGroup(secGrp SecurityGroup) SecurityGroup {
+	grants := make([]config.SecurityGroupGrant, len(secGrp.SecurityGroupGrants))
+	for i, grant := range secGrp.SecurityGroupGrants {
+		grant.ID = ""
+		grant.CidrIPs = normalizeStrings(grant.CidrIPs)
+		grant.CidrIPv6s = normalizeStrings(grant.CidrIPv6s)
+		grant.PrefixListIDs = normalizeStrings(grant.PrefixListIDs)
+		grant.SourceSecurityGroupNames = normalizeStrings(grant.SourceSecurityGroupNames)
+		grants[i] = grant
+	}
+	sort.Slice(grants, func(i, j int) bool {
+		return fmt.Sprintf("%+v", grants[i]) < fmt.Sprintf("%+v", grants[j])
+	})
+	secGrp.SecurityGroupGrants = grants
+	return secGrp
+}
+
+// normalizeLoadBalancer sorts the listeners and lists of a Load Balancer, AWS does not return them in any particular order
+func normalizeLoadBalancer(lb LoadBalancer) LoadBalancer {
+	listeners := make([]config.LoadBalancerListener, len(lb.LoadBalancerListeners))
+	for i, listener := range lb.LoadBalancerListeners {
+		listener.ID = ""
+		listeners[i] = listener
+	}
+	sort.Slice(listeners, func(i, j int) bool {
+		return fmt.Sprintf("%+v", listeners[i]) < fmt.Sprintf("%+v", listeners[j])
+	})
+	lb.LoadBalancerListeners = listeners
+	lb.AvailabilityZones = normalizeStrings(lb.AvailabilityZones)
+	lb.SecurityGroups = normalizeStrings(lb.SecurityGroups)
+	lb.SecurityGroupIDs = normalizeStrings(lb.SecurityGroupIDs)
+	lb.Subnets = normalizeStrings(lb.Subnets)
+	lb.SubnetClasses = normalizeStrings(lb.SubnetClasses)
+	lb.SubnetIDs = normalizeStrings(lb.SubnetIDs)
+	return lb
+}
+
+// planAssetState returns a hash of the normalized live state of an asset, used to refuse plans that were made against a different state
+func planAssetState(assetType, asset, region string) (string, error) {
+	search := "^" + regexp.QuoteMeta(asset) + "$"
+
+	switch assetType {
+	case "securitygroups":
+		secGrpList := new(SecurityGroups)
+		err := GetRegionSecurityGroups(region, secGrpList, search)
+		if err != nil {
+			return "", err
+		}
+		for _, secGrp := range *secGrpList {
+			if secGrp.GroupID == asset {
+				return planHash(normalizeSecurityGroup(secGrp))
+			}
+		}
+
+	case "loadbalancers":
+		lbList := new(LoadBalancers)
+		err := GetRegionLoadBalancers(region, lbList, search)
+		if err != nil {
+			return "", err
+		}
+		for _, lb := range *lbList {
+			if lb.Name == asset {
+				return planHash(normalizeLoadBalancer(lb))
+			}
+		}
+
+	case "autoscalegroups":
+		asgList := new(AutoScaleGroups)
+		err := GetRegionAutoScaleGroups(region, asgList, search)
+		if err != nil {
+			return "", err
+		}
+		for _, asg := range *asgList {
+			if asg.Name == asset {
+				// Leave out the counts that change with scaling activity
+				asg.InstanceCount = 0
+				asg.DesiredCapacity = 0
+				asg.AvailabilityZones = normalizeStrings(asg.AvailabilityZones)
+				asg.LoadBalancers = normalizeStrings(asg.LoadBalancers)
+				return planHash(asg)
+			}
+		}
+
+	default:
+		return "", errors.New("Asset type [" + assetType + "] can not be planned!")
+	}
+
+	return "", errors.New(assetType + " asset [" + asset + "] no longer exists in [" + region + "]!")
+}
+
+// Hash returns a hash of everything in the Plan
+func (p Plan) Hash() (string, error) {
+	return planHash(p)
+}
+
+// Write records the author and the live state of every asset in the Plan, writes it to a file and records its hash and author in the db
+func (p *Plan) Write(file string) error {
+
+	if len(p.Changes) == 0 {
+		terminal.Information("There are no changes to plan!")
+		return nil
+	}
+
+	createdBy, err := callerIdentity()
+	if err != nil {
+		return err
+	}
+	p.CreatedBy = createdBy
+	p.CreatedAt = time.Now().UTC()
+
+	states := make(map[string]string)
+	for i, change := range p.Changes {
+		key := change.AssetType + "/" + change.Region + "/" + change.Asset
+		if _, ok := states[key]; !ok {
+			states[key], err = planAssetState(change.AssetType, change.Asset, change.Region)
+			if err != nil {
+				return err
+			}
+		}
+		p.Changes[i].State = states[key]
+	}
+
+	out, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	hash, err := p.Hash()
+	if err != nil {
+		return err
+	}
+
+	err = config.SavePlanRecord(config.PlanRecord{
+		Hash:      hash,
+		CreatedBy: p.CreatedBy,
+		CreatedAt: p.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = ioutil.WriteFile(file, out, 0644)
+	if err != nil {
+		return err
+	}
+
+	p.PrintTable()
+	terminal.Delta(fmt.Sprintf("Wrote a plan with [%d] changes to [%s], it needs to be approved by someone else with `awsm approvePlan %s` before it can be applied.", len(p.Changes), file, file))
+
+	return nil
+}
+
+// LoadPlan reads a Plan from a file
+func LoadPlan(file string) (Plan, error) {
+	var plan Plan
+
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return plan, err
+	}
+
+	err = json.Unmarshal(data, &plan)
+	if err != nil {
+		return plan, errors.New("Unable to read the plan in [" + file + "]: " + err.Error())
+	}
+
+	if len(plan.Changes) == 0 {
+		return plan, errors.New("The plan in [" + file + "] does not have any changes!")
+	}
+
+	return plan, nil
+}
+
+// describe returns a short summary of a single change
+func (c PlanChange) describe() string {
+	switch {
+	case c.SecurityGroupChange != nil:
+		change := c.SecurityGroupChange
+		action := "Authorize"
+		if change.Revoke {
+			action = "Revoke"
+		} else if change.UpdateDescriptions {
+			action = "Describe"
+		}
+
+		var grants []string
+		for _, grant := range change.Grants {
+			grants = append(grants, fmt.Sprintf("[%s :%d-%d] [%s]", grant.IPProtocol, grant.FromPort, grant.ToPort, securityGroupGrantSources(grant)))
+		}
+		return fmt.Sprintf("%s %s %s", action, change.Type, strings.Join(grants, ", "))
+
+	case c.LoadBalancerChange != nil:
+		change := c.LoadBalancerChange
+		var parts []string
+		if len(change.Listeners) > 0 {
+			if change.Revoke {
+				parts = append(parts, fmt.Sprintf("Remove [%d] Listeners", len(change.Listeners)))
+			} else {
+				parts = append(parts, fmt.Sprintf("Add [%d] Listeners", len(change.Listeners)))
+			}
+		}
+		if change.Attributes != (config.LoadBalancerAttributes{}) {
+			parts = append(parts, "Update Attributes")
+		}
+		if change.HealthCheck != (config.LoadBalancerHealthCheck{}) {
+			parts = append(parts, "Update Health Check")
+		}
+		if len(change.SecurityGroups) > 0 {
+			parts = append(parts, "Apply Security Groups ["+strings.Join(change.SecurityGroups, ", ")+"]")
+		}
+		if len(change.AvailabilityZones) > 0 {
+			if change.Disable {
+				parts = append(parts, "Disable Availability Zones ["+strings.Join(change.AvailabilityZones, ", ")+"]")
+			} else {
+				parts = append(parts, "Enable Availability Zones ["+strings.Join(change.AvailabilityZones, ", ")+"]")
+			}
+		}
+		if len(change.Subnets) > 0 {
+			if change.Detach {
+				parts = append(parts, "Detach Subnets ["+strings.Join(change.Subnets, ", ")+"]")
+			} else {
+				parts = append(parts, "Attach Subnets ["+strings.Join(change.Subnets, ", ")+"]")
+			}
+		}
+		return strings.Join(parts, ", ")
+
+	case c.AutoScaleGroupChange != nil:
+		change := c.AutoScaleGroupChange
+		var parts []string
+		if change.Params != nil {
+			parts = append(parts, fmt.Sprintf("Update to [%s] in [%s] (min: %d, max: %d, desired: %d)", change.LaunchConfigurationName, change.Region,
+				aws.Int64Value(change.Params.MinSize), aws.Int64Value(change.Params.MaxSize), aws.Int64Value(change.Params.DesiredCapacity)))
+		}
+		if len(change.Alarms) > 0 {
+			var names []string
+			for _, alarm := range change.Alarms {
+				names = append(names, alarm.Name)
+			}
+			parts = append(parts, "Create Alarms ["+strings.Join(names, ", ")+"]")
+		}
+		return strings.Join(parts, ", ")
+	}
+
+	return ""
+}
+
+// PrintTable Prints an ascii table of the changes in a Plan
+func (p *Plan) PrintTable() {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Asset Type", "Asset", "Region", "Change"})
+
+	for _, change := range p.Changes {
+		table.Append([]string{change.AssetType, change.Asset, change.Region, change.describe()})
+	}
+
+	table.Render()
+
+	terminal.Information("Plan for [" + p.Command + "] created by [" + p.CreatedBy + "] at [" + p.CreatedAt.Format(time.RFC1123) + "]")
+}
+
+// planRecord looks up the recorded author of a Plan, only plans that were written with --plan-out and not modified since have one
+func planRecord(plan Plan) (config.PlanRecord, error) {
+	hash, err := plan.Hash()
+	if err != nil {
+		return config.PlanRecord{}, err
+	}
+
+	return config.LoadPlanRecord(hash)
+}
+
+// ApprovePlan records the approval of a Plan by someone other than its author
+func ApprovePlan(file string) error {
+
+	plan, err := LoadPlan(file)
+	if err != nil {
+		return err
+	}
+
+	plan.PrintTable()
+
+	record, err := planRecord(plan)
+	if err != nil {
+		return err
+	}
+
+	approver, err := callerIdentity()
+	if err != nil {
+		return err
+	}
+
+	if approver == record.CreatedBy {
+		return errors.New("Plans need to be approved by someone other than their author, Aborting!")
+	}
+
+	// Confirm
+	if !terminal.PromptBool("Are you sure you want to approve this plan?") {
+		return errors.New("Aborting!")
+	}
+
+	err = config.SavePlanApproval(config.PlanApproval{
+		Hash: record.Hash,
+		By:   approver,
+		At:   time.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+
+	terminal.Delta("Approved the plan in [" + file + "] as [" + approver + "]!")
+
+	return nil
+}
+
+// ApplyPlan applies exactly the changes in an approved Plan, refusing if any of the assets changed since the plan was made
+func ApplyPlan(file string, dryRun bool) error {
+
+	// --dry-run flag
+	if dryRun {
+		terminal.Information("--dry-run flag is set, not making any actual changes!")
+	}
+
+	plan, err := LoadPlan(file)
+	if err != nil {
+		return err
+	}
+
+	plan.PrintTable()
+
+	record, err := planRecord(plan)
+	if err != nil {
+		return err
+	}
+
+	caller, err := callerIdentity()
+	if err != nil {
+		return err
+	}
+
+	approvals, err := config.LoadPlanApprovals(record.Hash)
+	if err != nil {
+		return err
+	}
+
+	// The approval has to come from someone other than both the author and whoever is applying the plan
+	approved := false
+	for _, approval := range approvals {
+		if approval.By != record.CreatedBy && approval.By != caller {
+			terminal.Information("Approved by [" + approval.By + "] at [" + approval.At.Format(time.RFC1123) + "]")
+			approved = true
+		}
+	}
+	if !approved {
+		return errors.New("This plan has not been approved by a second engineer, run `awsm approvePlan " + file + "` as someone other than its author. Aborting!")
+	}
+
+	// Check every asset before changing any of them, some assets have more than one change
+	terminal.Delta("Comparing the live state of the planned assets...")
+	states := make(map[string]string)
+	for _, change := range plan.Changes {
+		key := change.AssetType + "/" + change.Region + "/" + change.Asset
+		if _, ok := states[key]; !ok {
+			state, err := planAssetState(change.AssetType, change.Asset, change.Region)
+			if err != nil {
+				return err
+			}
+			states[key] = state
+		}
+
+		if states[key] != change.State {
+			return errors.New(change.AssetType + " asset [" + change.Asset + "] in [" + change.Region + "] has changed since the plan was made, please make a new plan. Aborting!")
+		}
+	}
+
+	// Confirm
+	if !terminal.PromptBool("Are you sure you want to apply this plan?") {
+		return errors.New("Aborting!")
+	}
+
+	var secGrpChanges SecurityGroupChanges
+	var lbChanges []LoadBalancerChange
+	var asgChanges []AutoScaleGroupChange
+
+	for _, change := range plan.Changes {
+		switch {
+		case change.SecurityGroupChange != nil:
+			secGrpChanges = append(secGrpChanges, *change.SecurityGroupChange)
+		case change.LoadBalancerChange != nil:
+			lbChanges = append(lbChanges, *change.LoadBalancerChange)
+		case change.AutoScaleGroupChange != nil:
+			asgChanges = append(asgChanges, *change.AutoScaleGroupChange)
+		}
+	}
+
+	if len(secGrpChanges) > 0 {
+		err = updateSecurityGroups(secGrpChanges, dryRun)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(lbChanges) > 0 {
+		err = updateLoadBalancers(lbChanges, dryRun)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(asgChanges) > 0 {
+		err = updateAutoScaleGroups(asgChanges, dryRun)
+		if err != nil {
+			return err
+		}
+	}
+
+	terminal.Information("Done!")
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package aws
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/murdinc/awsm/config"
+)
+
+func testPlan() Plan {
+	return Plan{
+		Command:   "updateSecurityGroups web",
+		CreatedBy: "arn:aws:iam::123456789012:user/alice",
+		CreatedAt: time.Date(2026, 10, 18, 12, 30, 15, 123456789, time.UTC),
+		Changes: []PlanChange{
+			{
+				AssetType: "securitygroups",
+				Asset:     "web",
+				Region:    "us-east-1",
+				State:     "0123456789abcdef",
+				SecurityGroupChange: &SecurityGroupChange{
+					Group: SecurityGroup{Name: "web", Class: "web", GroupID: "sg-12345678", Region: "us-east-1"},
+					Type:  "ingress",
+					Grants: []config.SecurityGroupGrant{
+						{Type: "ingress", IPProtocol: "tcp", FromPort: 443, ToPort: 443, CidrIPs: []string{"0.0.0.0/0"}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestPlanHashRoundTrip(t *testing.T) {
+	plan := testPlan()
+
+	hash, err := plan.Hash()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := ioutil.TempDir("", "awsm-plan")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Written the same way Write does
+	out, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(dir, "plan.json")
+	err = ioutil.WriteFile(file, out, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadPlan(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loadedHash, err := loaded.Hash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loadedHash != hash {
+		t.Errorf("hash of the loaded plan = %s, want %s", loadedHash, hash)
+	}
+
+	// Any edit to the plan file changes its hash
+	loaded.Changes[0].SecurityGroupChange.Grants[0].CidrIPs = []string{"10.0.0.0/8"}
+	editedHash, err := loaded.Hash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if editedHash == hash {
+		t.Errorf("hash of an edited plan should not match the original hash %s", hash)
+	}
+}
+
+func TestNormalizeSecurityGroupHash(t *testing.T) {
+	a := SecurityGroup{
+		Name: "web",
+		SecurityGroupGrants: []config.SecurityGroupGrant{
+			{ID: "1", Type: "ingress", IPProtocol: "tcp", FromPort: 80, ToPort: 80, CidrIPs: []string{"10.0.0.0/8", "0.0.0.0/0"}},
+			{ID: "2", Type: "ingress", IPProtocol: "tcp", FromPort: 443, ToPort: 443, CidrIPs: []string{"0.0.0.0/0"}},
+		},
+	}
+	b := SecurityGroup{
+		Name: "web",
+		SecurityGroupGrants: []config.SecurityGroupGrant{
+			{ID: "3", Type: "ingress", IPProtocol: "tcp", FromPort: 443, ToPort: 443, CidrIPs: []string{"0.0.0.0/0"}},
+			{ID: "4", Type: "ingress", IPProtocol: "tcp", FromPort: 80, ToPort: 80, CidrIPs: []string{"0.0.0.0/0", "10.0.0.0/8"}},
+		},
+	}
+
+	hashA, err := planHash(normalizeSecurityGroup(a))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hashB, err := planHash(normalizeSecurityGroup(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hashA != hashB {
+		t.Errorf("the same grants in a different order hash differently: %s and %s", hashA, hashB)
+	}
+}
@@ -0,0 +1,260 @@
+package aws
+
+import (
+	"errors"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/murdinc/awsm/config"
+	"github.com/murdinc/awsm/models"
+	"github.com/murdinc/terminal"
+	"github.com/olekukonko/tablewriter"
+	"github.com/robfig/cron"
+)
+
+// Schedules represents a slice of scheduled Snapshot and Image classes
+type Schedules []Schedule
+
+// Schedule represents a single scheduled Snapshot or Image class
+type Schedule models.Schedule
+
+// scheduler holds the state of the scheduler running in this process
+var scheduler = struct {
+	sync.Mutex
+	started  time.Time
+	running  map[string]bool
+	lastRuns map[string]config.ScheduleRun // dry-run results, which are never saved
+}{
+	running:  make(map[string]bool),
+	lastRuns: make(map[string]config.ScheduleRun),
+}
+
+// SchedulerStarted returns true if a scheduler is running in this process
+func SchedulerStarted() bool {
+	scheduler.Lock()
+	defer scheduler.Unlock()
+	return !scheduler.started.IsZero()
+}
+
+// StartScheduler evaluates the schedule expressions of Snapshot and Image classes every minute and runs them when they are due.
+// It blocks forever, run it in a goroutine when hosting it alongside something else.
+func StartScheduler(dryRun bool) error {
+
+	// --dry-run flag
+	if dryRun {
+		terminal.Information("--dry-run flag is set, not making any actual changes!")
+	}
+
+	scheduler.Lock()
+	if !scheduler.started.IsZero() {
+		scheduler.Unlock()
+		return errors.New("The scheduler is already running!")
+	}
+	scheduler.started = time.Now()
+	scheduler.Unlock()
+
+	schedules, err := GetSchedules()
+	if err != nil {
+		terminal.ErrorLine(err.Error())
+	}
+	if len(schedules) == 0 {
+		terminal.Notice("No Snapshot or Image classes have a schedule configured yet, they will be picked up once they do.")
+	} else {
+		schedules.PrintTable()
+	}
+
+	terminal.Information("Scheduler started!")
+
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		runDueSchedules(dryRun)
+		<-ticker.C
+	}
+}
+
+// runDueSchedules runs every schedule with a next run time in the past that isn't already running
+func runDueSchedules(dryRun bool) {
+	schedules, err := GetSchedules()
+	if err != nil {
+		terminal.ErrorLine("Error loading schedules: " + err.Error())
+		return
+	}
+
+	now := time.Now()
+	for _, schedule := range schedules {
+		if schedule.Running || schedule.NextRun.IsZero() || schedule.NextRun.After(now) {
+			continue
+		}
+
+		scheduler.Lock()
+		scheduler.running[schedule.ClassType+"/"+schedule.Class] = true
+		scheduler.Unlock()
+
+		go runSchedule(schedule, dryRun)
+	}
+}
+
+// runSchedule runs a single schedule non-interactively and records the result
+func runSchedule(schedule Schedule, dryRun bool) {
+	key := schedule.ClassType + "/" + schedule.Class
+
+	terminal.Notice("Running scheduled [" + schedule.ClassType + "] class [" + schedule.Class + "]...")
+
+	var err error
+	switch schedule.ClassType {
+	case "snapshots":
+		err = CreateSnapshot(schedule.Class, "", false, true, dryRun)
+	case "images":
+		err = CreateImage(schedule.Class, "", dryRun)
+	default:
+		err = errors.New("Unable to schedule class type [" + schedule.ClassType + "]!")
+	}
+
+	run := config.ScheduleRun{
+		ClassType: schedule.ClassType,
+		Class:     schedule.Class,
+		LastRun:   time.Now(),
+		Success:   err == nil,
+		Result:    "Completed",
+	}
+
+	if err != nil {
+		run.Result = err.Error()
+		terminal.ShowErrorMessage("Scheduled ["+schedule.ClassType+"] class ["+schedule.Class+"] failed!", err.Error())
+	} else {
+		terminal.Delta("Scheduled [" + schedule.ClassType + "] class [" + schedule.Class + "] completed!")
+	}
+
+	scheduler.Lock()
+	defer scheduler.Unlock()
+
+	delete(scheduler.running, key)
+
+	if dryRun {
+		scheduler.lastRuns[key] = run
+		return
+	}
+
+	err = config.SaveScheduleRun(run)
+	if err != nil {
+		terminal.ErrorLine("Error recording the run of scheduled [" + schedule.ClassType + "] class [" + schedule.Class + "]: " + err.Error())
+		scheduler.lastRuns[key] = run
+	}
+}
+
+// GetSchedules returns the Snapshot and Image classes that have a schedule expression, along with their last and next runs
+func GetSchedules() (Schedules, error) {
+	var schedules Schedules
+
+	snapshotCfgs, err := config.LoadAllSnapshotClasses()
+	if err != nil {
+		return schedules, err
+	}
+
+	imageCfgs, err := config.LoadAllImageClasses()
+	if err != nil {
+		return schedules, err
+	}
+
+	// No runs recorded yet is not an error
+	runs, _ := config.LoadAllScheduleRuns()
+
+	scheduler.Lock()
+	defer scheduler.Unlock()
+
+	for class, cfg := range snapshotCfgs {
+		if cfg.Schedule != "" {
+			schedules = append(schedules, buildSchedule("snapshots", class, cfg.Schedule, runs))
+		}
+	}
+
+	for class, cfg := range imageCfgs {
+		if cfg.Schedule != "" {
+			schedules = append(schedules, buildSchedule("images", class, cfg.Schedule, runs))
+		}
+	}
+
+	sort.Sort(schedules)
+
+	return schedules, nil
+}
+
+// buildSchedule builds a Schedule from a class schedule expression and its last recorded run. Must be called with the scheduler locked.
+func buildSchedule(classType, class, expression string, runs config.ScheduleRuns) Schedule {
+	key := classType + "/" + class
+
+	schedule := Schedule{
+		ClassType: classType,
+		Class:     class,
+		Schedule:  expression,
+		Running:   scheduler.running[key],
+	}
+
+	run, ok := scheduler.lastRuns[key]
+	if !ok {
+		run = runs[key]
+	}
+
+	schedule.LastRun = run.LastRun
+	schedule.Success = run.Success
+	schedule.Result = run.Result
+
+	cronSchedule, err := cron.ParseStandard(expression)
+	if err != nil {
+		schedule.Success = false
+		schedule.Result = "Invalid schedule expression: " + err.Error()
+		return schedule
+	}
+
+	// Classes that have never run are due on their first occurrence after the scheduler started
+	from := run.LastRun
+	if from.IsZero() {
+		from = scheduler.started
+		if from.IsZero() {
+			from = time.Now()
+		}
+	}
+
+	schedule.NextRun = cronSchedule.Next(from)
+
+	return schedule
+}
+
+func (s Schedules) Len() int {
+	return len(s)
+}
+
+func (s Schedules) Less(i, j int) bool {
+	if s[i].ClassType != s[j].ClassType {
+		return s[i].ClassType > s[j].ClassType
+	}
+	return s[i].Class < s[j].Class
+}
+
+func (s Schedules) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+// PrintTable Prints an ascii table of the list of Schedules
+func (s *Schedules) PrintTable() {
+	if len(*s) == 0 {
+		terminal.ShowErrorMessage("Warning", "No Schedules Found!")
+		return
+	}
+
+	var header []string
+	rows := make([][]string, len(*s))
+
+	for index, schedule := range *s {
+		models.ExtractAwsmTable(index, schedule, &header, &rows)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(header)
+	table.AppendBulk(rows)
+	table.Render()
+}
@@ -267,6 +267,7 @@ func (s *SecurityGroup) Marshal(securitygroup *ec2.SecurityGroup, region string,
 	s.Name = GetTagValue("Name", securitygroup.Tags)
 	s.Class = GetTagValue("Class", securitygroup.Tags)
 	s.GroupID = aws.StringValue(securitygroup.GroupId)
+	s.GroupName = aws.StringValue(securitygroup.GroupName)
 	s.Description = aws.StringValue(securitygroup.Description)
 	s.Vpc = vpc
 	s.VpcID = aws.StringValue(securitygroup.VpcId)
@@ -279,48 +280,59 @@ func (s *SecurityGroup) Marshal(securitygroup *ec2.SecurityGroup, region string,
 
 	// Get the ingress grants
 	for _, grant := range securitygroup.IpPermissions {
+		s.SecurityGroupGrants = append(s.SecurityGroupGrants, marshalSecurityGroupGrants("ingress", grant)...)
+	}
 
-		var cidr, group []string
-		for _, ipRange := range grant.IpRanges {
-			cidr = append(cidr, aws.StringValue(ipRange.CidrIp))
-		}
+	// Get the egress grants
+	for _, grant := range securitygroup.IpPermissionsEgress {
+		s.SecurityGroupGrants = append(s.SecurityGroupGrants, marshalSecurityGroupGrants("egress", grant)...)
+	}
 
-		for _, groupPairs := range grant.UserIdGroupPairs {
-			group = append(group, aws.StringValue(groupPairs.GroupName))
-		}
+}
+
+// marshalSecurityGroupGrants splits an IpPermission into one grant per source, so that each keeps its own description
+func marshalSecurityGroupGrants(grantType string, permission *ec2.IpPermission) (grants []config.SecurityGroupGrant) {
 
-		s.SecurityGroupGrants = append(s.SecurityGroupGrants, config.SecurityGroupGrant{
-			Type:                     "ingress",
-			FromPort:                 int(aws.Int64Value(grant.FromPort)),
-			ToPort:                   int(aws.Int64Value(grant.ToPort)),
-			IPProtocol:               aws.StringValue(grant.IpProtocol),
-			CidrIPs:                  cidr,
-			SourceSecurityGroupNames: group,
-		})
+	grant := config.SecurityGroupGrant{
+		Type:                     grantType,
+		FromPort:                 int(aws.Int64Value(permission.FromPort)),
+		ToPort:                   int(aws.Int64Value(permission.ToPort)),
+		IPProtocol:               aws.StringValue(permission.IpProtocol),
+		CidrIPs:                  []string{},
+		CidrIPv6s:                []string{},
+		PrefixListIDs:            []string{},
+		SourceSecurityGroupNames: []string{},
 	}
 
-	// Get the egress grants
-	for _, grant := range securitygroup.IpPermissionsEgress {
+	for _, ipRange := range permission.IpRanges {
+		cidrIpGrant := grant
+		cidrIpGrant.Note = aws.StringValue(ipRange.Description)
+		cidrIpGrant.CidrIPs = []string{aws.StringValue(ipRange.CidrIp)}
+		grants = append(grants, cidrIpGrant)
+	}
 
-		var cidr, group []string
-		for _, ipRange := range grant.IpRanges {
-			cidr = append(cidr, aws.StringValue(ipRange.CidrIp))
-		}
+	for _, ipv6Range := range permission.Ipv6Ranges {
+		cidrIpv6Grant := grant
+		cidrIpv6Grant.Note = aws.StringValue(ipv6Range.Description)
+		cidrIpv6Grant.CidrIPv6s = []string{aws.StringValue(ipv6Range.CidrIpv6)}
+		grants = append(grants, cidrIpv6Grant)
+	}
 
-		for _, groupPairs := range grant.UserIdGroupPairs {
-			group = append(group, aws.StringValue(groupPairs.GroupName))
-		}
+	for _, prefixList := range permission.PrefixListIds {
+		prefixListGrant := grant
+		prefixListGrant.Note = aws.StringValue(prefixList.Description)
+		prefixListGrant.PrefixListIDs = []string{aws.StringValue(prefixList.PrefixListId)}
+		grants = append(grants, prefixListGrant)
+	}
 
-		s.SecurityGroupGrants = append(s.SecurityGroupGrants, config.SecurityGroupGrant{
-			Type:                     "egress",
-			FromPort:                 int(aws.Int64Value(grant.FromPort)),
-			ToPort:                   int(aws.Int64Value(grant.ToPort)),
-			IPProtocol:               aws.StringValue(grant.IpProtocol),
-			CidrIPs:                  cidr,
-			SourceSecurityGroupNames: group,
-		})
+	for _, groupPair := range permission.UserIdGroupPairs {
+		secGrpGrant := grant
+		secGrpGrant.Note = aws.StringValue(groupPair.Description)
+		secGrpGrant.SourceSecurityGroupNames = []string{aws.StringValue(groupPair.GroupName)}
+		grants = append(grants, secGrpGrant)
 	}
 
+	return grants
 }
 
 // PrintTable Prints an ascii table of the list of Security Groups
@@ -351,6 +363,12 @@ func CreateSecurityGroup(class, region, vpc string, dryRun bool) error {
 		terminal.Information("--dry-run flag is set, not making any actual changes!")
 	}
 
+	return createSecurityGroup(class, region, vpc, true, dryRun)
+}
+
+// private function without terminal prompts, groupGrants controls whether grants from other Security Groups are authorized as well
+func createSecurityGroup(class, region, vpc string, groupGrants, dryRun bool) error {
+
 	// Verify the security group class input
 	cfg, err := config.LoadSecurityGroupClass(class, false)
 	if err != nil {
@@ -435,9 +453,79 @@ func CreateSecurityGroup(class, region, vpc string, dryRun bool) error {
 		return err
 	}
 
+	if !groupGrants {
+		changes = withoutGroupGrants(changes)
+	}
+
 	return updateSecurityGroups(changes, dryRun)
 }
 
+// authorizeSecurityGroupGroupGrants authorizes the grants from other Security Groups of a class on its existing group, once the groups
+// that it references exist
+func authorizeSecurityGroupGroupGrants(class, region, vpcID string) error {
+
+	secGrpList := new(SecurityGroups)
+	err := GetRegionSecurityGroups(region, secGrpList, "")
+	if err != nil {
+		return err
+	}
+
+	for _, secGrp := range *secGrpList {
+		if secGrp.Class != class || (vpcID != "" && secGrp.VpcID != vpcID) {
+			continue
+		}
+
+		changes, err := SecurityGroups{secGrp}.Diff()
+		if err != nil {
+			return err
+		}
+
+		return updateSecurityGroups(onlyGroupGrants(changes), false)
+	}
+
+	return errors.New("Security Group [" + class + "] does not exist in [" + region + "]!")
+}
+
+// withoutGroupGrants removes the authorizations of grants from other Security Groups from a list of changes
+func withoutGroupGrants(changes []SecurityGroupChange) (filtered []SecurityGroupChange) {
+	for _, change := range changes {
+		if !change.Revoke && !change.UpdateDescriptions {
+			var grants []config.SecurityGroupGrant
+			for _, grant := range change.Grants {
+				if len(grant.SourceSecurityGroupNames) == 0 {
+					grants = append(grants, grant)
+				}
+			}
+			if len(grants) == 0 {
+				continue
+			}
+			change.Grants = grants
+		}
+		filtered = append(filtered, change)
+	}
+	return filtered
+}
+
+// onlyGroupGrants keeps only the authorizations of grants from other Security Groups from a list of changes
+func onlyGroupGrants(changes []SecurityGroupChange) (filtered []SecurityGroupChange) {
+	for _, change := range changes {
+		if change.Revoke || change.UpdateDescriptions {
+			continue
+		}
+		var grants []config.SecurityGroupGrant
+		for _, grant := range change.Grants {
+			if len(grant.SourceSecurityGroupNames) > 0 {
+				grants = append(grants, grant)
+			}
+		}
+		if len(grants) > 0 {
+			change.Grants = grants
+			filtered = append(filtered, change)
+		}
+	}
+	return filtered
+}
+
 // DeleteSecurityGroups deletes one or more Security Groups that match the provided search term and optional region
 func DeleteSecurityGroups(search, region string, dryRun bool) (err error) {
 
@@ -485,8 +573,9 @@ func DeleteSecurityGroups(search, region string, dryRun bool) (err error) {
 	return nil
 }
 
-// UpdateSecurityGroups updates one or more Security Groups that match the provided search term and optional region
-func UpdateSecurityGroups(search, region string, dryRun bool) (err error) {
+// UpdateSecurityGroups updates one or more Security Groups that match the provided search term and optional region, or writes the changes
+// to a plan file to be applied later
+func UpdateSecurityGroups(search, region, planOut string, dryRun bool) (err error) {
 
 	// --dry-run flag
 	if dryRun {
@@ -523,6 +612,20 @@ func UpdateSecurityGroups(search, region string, dryRun bool) (err error) {
 		return nil
 	}
 
+	// --plan-out flag
+	if planOut != "" {
+		plan := Plan{Command: "updateSecurityGroups"}
+		for i := range changes {
+			plan.Changes = append(plan.Changes, PlanChange{
+				AssetType:           "securitygroups",
+				Asset:               changes[i].Group.GroupID,
+				Region:              changes[i].Group.Region,
+				SecurityGroupChange: &changes[i],
+			})
+		}
+		return plan.Write(planOut)
+	}
+
 	// Confirm
 	if !terminal.PromptBool("Are you sure you want to update these Security Groups?") {
 		return errors.New("Aborting!")
@@ -540,18 +643,96 @@ func UpdateSecurityGroups(search, region string, dryRun bool) (err error) {
 	return nil
 }
 
+// SecurityGroupChanges represents a slice of Security Group Changes
 type SecurityGroupChanges []SecurityGroupChange
 
+// SecurityGroupChange represents a set of grants to authorize, revoke or update the descriptions of on a single Security Group
 type SecurityGroupChange struct {
-	Group  SecurityGroup
-	Revoke bool
-	Type   string
-	Grants []config.SecurityGroupGrant
+	Group              SecurityGroup
+	Revoke             bool
+	UpdateDescriptions bool
+	Type               string
+	Grants             []config.SecurityGroupGrant
+}
+
+// splitSecurityGroupGrant splits a grant into one grant per source, IPv6 addresses found in CidrIPs are moved to CidrIPv6s
+func splitSecurityGroupGrant(grant config.SecurityGroupGrant) (grants []config.SecurityGroupGrant) {
+
+	sGrant := grant
+	sGrant.CidrIPs = []string{}
+	sGrant.CidrIPv6s = []string{}
+	sGrant.PrefixListIDs = []string{}
+	sGrant.SourceSecurityGroupNames = []string{}
+
+	for _, ipGrant := range grant.CidrIPs {
+		cidrIpGrant := sGrant
+
+		address, _, _ := net.ParseCIDR(ipGrant)
+		if address != nil && govalidator.IsIPv6(address.String()) {
+			cidrIpGrant.CidrIPv6s = []string{ipGrant}
+		} else {
+			cidrIpGrant.CidrIPs = []string{ipGrant}
+		}
+
+		grants = append(grants, cidrIpGrant)
+	}
+
+	for _, ipv6Grant := range grant.CidrIPv6s {
+		cidrIpv6Grant := sGrant
+		cidrIpv6Grant.CidrIPv6s = []string{ipv6Grant}
+		grants = append(grants, cidrIpv6Grant)
+	}
+
+	for _, prefixListGrant := range grant.PrefixListIDs {
+		prefixGrant := sGrant
+		prefixGrant.PrefixListIDs = []string{prefixListGrant}
+		grants = append(grants, prefixGrant)
+	}
+
+	for _, secGrant := range grant.SourceSecurityGroupNames {
+		secGrpGrant := sGrant
+		secGrpGrant.SourceSecurityGroupNames = []string{secGrant}
+		grants = append(grants, secGrpGrant)
+	}
+
+	return grants
+}
+
+// securityGroupGrantSources returns a comma separated list of all sources of a grant
+func securityGroupGrantSources(grant config.SecurityGroupGrant) string {
+	var sources []string
+	sources = append(sources, grant.CidrIPs...)
+	sources = append(sources, grant.CidrIPv6s...)
+	sources = append(sources, grant.PrefixListIDs...)
+	sources = append(sources, grant.SourceSecurityGroupNames...)
+	return strings.Join(sources, ", ")
 }
 
+// Diff compares Security Groups with their classes and returns the changes needed
 func (s SecurityGroups) Diff() ([]SecurityGroupChange, error) {
+	return s.diff(true)
+}
 
-	terminal.Delta("Comparing awsm Security Group grants...")
+// diff compares Security Groups with their classes, printing the changes it finds when verbose
+func (s SecurityGroups) diff(verbose bool) ([]SecurityGroupChange, error) {
+
+	delta := func(msg string) {
+		if verbose {
+			terminal.Delta(msg)
+		}
+	}
+	notice := func(msg string) {
+		if verbose {
+			terminal.Notice(msg)
+		}
+	}
+	information := func(msg string) {
+		if verbose {
+			terminal.Information(msg)
+		}
+	}
+
+	delta("Comparing awsm Security Group grants...")
 
 	changes := []SecurityGroupChange{}
 	cfgHashes := make([]map[uint64]config.SecurityGroupGrant, len(s))
@@ -566,91 +747,56 @@ func (s SecurityGroups) Diff() ([]SecurityGroupChange, error) {
 
 		// cycle through the config grants and generate hashess
 		for _, cGrant := range cfg.SecurityGroupGrants {
-
-			for _, ipGrant := range cGrant.CidrIPs {
-				cidrIpGrant := cGrant
-				cidrIpGrant.CidrIPs = []string{ipGrant}
-				cidrIpGrant.SourceSecurityGroupNames = []string{}
-
-				configGrantHash, err := hashstructure.Hash(cidrIpGrant, nil)
-				if err != nil {
-					return changes, err
-				}
-				cfgHashes[i][configGrantHash] = cidrIpGrant
-			}
-
-			for _, secGrant := range cGrant.SourceSecurityGroupNames {
-				secGrpGrant := cGrant
-				secGrpGrant.CidrIPs = []string{}
-				secGrpGrant.SourceSecurityGroupNames = []string{secGrant}
-
-				configGrantHash, err := hashstructure.Hash(secGrpGrant, nil)
+			for _, sGrant := range splitSecurityGroupGrant(cGrant) {
+				configGrantHash, err := hashstructure.Hash(sGrant, nil)
 				if err != nil {
 					return changes, err
 				}
-				cfgHashes[i][configGrantHash] = secGrpGrant
+				cfgHashes[i][configGrantHash] = sGrant
 			}
-
 		}
 
 	}
 
 	for i, secGrp := range s {
 
-		var removeIngress, removeEgress, addIngress, addEgress []config.SecurityGroupGrant
+		var removeIngress, removeEgress, addIngress, addEgress, describeIngress, describeEgress []config.SecurityGroupGrant
 
 		// cycle through existing grants and find ones to remove
 		for _, grant := range secGrp.SecurityGroupGrants {
 
-			for _, ipGrant := range grant.CidrIPs {
-				cidrIpGrant := grant
-				cidrIpGrant.CidrIPs = []string{ipGrant}
-				cidrIpGrant.SourceSecurityGroupNames = []string{}
+			for _, sGrant := range splitSecurityGroupGrant(grant) {
 
-				existingGrantHash, err := hashstructure.Hash(cidrIpGrant, nil)
+				existingGrantHash, err := hashstructure.Hash(sGrant, nil)
 				if err != nil {
 					return changes, err
 				}
 
-				if _, ok := cfgHashes[i][existingGrantHash]; !ok {
-					terminal.Delta(fmt.Sprintf("[%s %s] - Deauthorize - [%s]	[%s :%d-%d]	[%s]", secGrp.Name, secGrp.Region, cidrIpGrant.Type, cidrIpGrant.IPProtocol, cidrIpGrant.FromPort, cidrIpGrant.ToPort, strings.Join(cidrIpGrant.CidrIPs, ", ")))
+				cfgGrant, ok := cfgHashes[i][existingGrantHash]
+				if !ok {
+					delta(fmt.Sprintf("[%s %s] - Deauthorize - [%s]	[%s :%d-%d]	[%s]", secGrp.Name, secGrp.Region, sGrant.Type, sGrant.IPProtocol, sGrant.FromPort, sGrant.ToPort, securityGroupGrantSources(sGrant)))
 
-					if cidrIpGrant.Type == "ingress" {
-						removeIngress = append(removeIngress, cidrIpGrant)
-					} else if cidrIpGrant.Type == "egress" {
-						removeEgress = append(removeEgress, cidrIpGrant)
+					if sGrant.Type == "ingress" {
+						removeIngress = append(removeIngress, sGrant)
+					} else if sGrant.Type == "egress" {
+						removeEgress = append(removeEgress, sGrant)
 					}
 
-				} else {
-					//terminal.Notice(fmt.Sprintf("[%s %s] - Keeping - [%s]	[%s :%d-%d]	[%s]", secGrp.Name, secGrp.Region, cidrIpGrant.Type, cidrIpGrant.IPProtocol, cidrIpGrant.FromPort, cidrIpGrant.ToPort, strings.Join(cidrIpGrant.CidrIPs, ", ")))
-					delete(cfgHashes[i], existingGrantHash)
-				}
-			}
-
-			for _, secGrant := range grant.SourceSecurityGroupNames {
-				secGrpGrant := grant
-				secGrpGrant.CidrIPs = []string{}
-				secGrpGrant.SourceSecurityGroupNames = []string{secGrant}
-
-				existingGrantHash, err := hashstructure.Hash(secGrpGrant, nil)
-				if err != nil {
-					return changes, err
+					continue
 				}
 
-				if _, ok := cfgHashes[i][existingGrantHash]; !ok {
-					terminal.Delta(fmt.Sprintf("[%s %s] - Deauthorize - [%s]	[%s :%d-%d]	[%s]", secGrp.Name, secGrp.Region, secGrpGrant.Type, secGrpGrant.IPProtocol, secGrpGrant.FromPort, secGrpGrant.ToPort, strings.Join(secGrpGrant.SourceSecurityGroupNames, ", ")))
+				// Same rule, only the description changed
+				if cfgGrant.Note != sGrant.Note {
+					delta(fmt.Sprintf("[%s %s] - Describe - [%s]	[%s :%d-%d]	[%s]	[%s]", secGrp.Name, secGrp.Region, cfgGrant.Type, cfgGrant.IPProtocol, cfgGrant.FromPort, cfgGrant.ToPort, securityGroupGrantSources(cfgGrant), cfgGrant.Note))
 
-					if secGrpGrant.Type == "ingress" {
-						removeIngress = append(removeIngress, secGrpGrant)
-					} else if secGrpGrant.Type == "egress" {
-						removeEgress = append(removeEgress, secGrpGrant)
+					if cfgGrant.Type == "ingress" {
+						describeIngress = append(describeIngress, cfgGrant)
+					} else if cfgGrant.Type == "egress" {
+						describeEgress = append(describeEgress, cfgGrant)
 					}
-
-				} else {
-					//terminal.Notice(fmt.Sprintf("[%s %s] - Keeping - [%s]	[%s :%d-%d]	[%s]", secGrp.Name, secGrp.Region, secGrpGrant.Type, secGrpGrant.IPProtocol, secGrpGrant.FromPort, secGrpGrant.ToPort, strings.Join(secGrpGrant.SourceSecurityGroupNames, ", ")))
-					delete(cfgHashes[i], existingGrantHash)
 				}
 
+				delete(cfgHashes[i], existingGrantHash)
 			}
 
 		}
@@ -658,39 +804,18 @@ func (s SecurityGroups) Diff() ([]SecurityGroupChange, error) {
 		// cycle through hashes and find ones to add
 		for _, grant := range cfgHashes[i] {
 
-			sGrant := grant
-
 			// Skip egress rules on non vpc security groups
 			if secGrp.VpcID == "" && grant.Type == "egress" {
-				terminal.Notice(fmt.Sprintf("[%s %s] - Skip - [%s]	[%s :%d-%d]	Egress rules can only be applied to VPC Security Groups", secGrp.Name, secGrp.Region, sGrant.Type, sGrant.IPProtocol, sGrant.FromPort, sGrant.ToPort))
+				notice(fmt.Sprintf("[%s %s] - Skip - [%s]	[%s :%d-%d]	Egress rules can only be applied to VPC Security Groups", secGrp.Name, secGrp.Region, grant.Type, grant.IPProtocol, grant.FromPort, grant.ToPort))
 				continue
 			}
 
-			for _, ipGrant := range grant.CidrIPs {
-				sGrant.CidrIPs = []string{ipGrant}
-				sGrant.SourceSecurityGroupNames = []string{}
+			delta(fmt.Sprintf("[%s %s] - Authorize - [%s]	[%s :%d-%d]	[%s]", secGrp.Name, secGrp.Region, grant.Type, grant.IPProtocol, grant.FromPort, grant.ToPort, securityGroupGrantSources(grant)))
 
-				terminal.Delta(fmt.Sprintf("[%s %s] - Authorize - [%s]	[%s :%d-%d]	[%s]", secGrp.Name, secGrp.Region, sGrant.Type, sGrant.IPProtocol, sGrant.FromPort, sGrant.ToPort, strings.Join(sGrant.CidrIPs, ", ")))
-
-				if grant.Type == "ingress" {
-					addIngress = append(addIngress, sGrant)
-				} else if grant.Type == "egress" {
-					addEgress = append(addEgress, sGrant)
-				}
-			}
-
-			for _, secGrant := range grant.SourceSecurityGroupNames {
-
-				sGrant.CidrIPs = []string{}
-				sGrant.SourceSecurityGroupNames = []string{secGrant}
-
-				terminal.Delta(fmt.Sprintf("[%s %s] - Authorize - [%s]	[%s :%d-%d]	[%s]", secGrp.Name, secGrp.Region, sGrant.Type, sGrant.IPProtocol, sGrant.FromPort, sGrant.ToPort, strings.Join(sGrant.SourceSecurityGroupNames, ", ")))
-
-				if grant.Type == "ingress" {
-					addIngress = append(addIngress, sGrant)
-				} else if grant.Type == "egress" {
-					addEgress = append(addEgress, sGrant)
-				}
+			if grant.Type == "ingress" {
+				addIngress = append(addIngress, grant)
+			} else if grant.Type == "egress" {
+				addEgress = append(addEgress, grant)
 			}
 		}
 
@@ -711,6 +836,23 @@ func (s SecurityGroups) Diff() ([]SecurityGroupChange, error) {
 				Grants: addEgress,
 			})
 		}
+		// update descriptions
+		if len(describeIngress) > 0 {
+			changes = append(changes, SecurityGroupChange{
+				Group:              secGrp,
+				UpdateDescriptions: true,
+				Type:               "ingress",
+				Grants:             describeIngress,
+			})
+		}
+		if len(describeEgress) > 0 {
+			changes = append(changes, SecurityGroupChange{
+				Group:              secGrp,
+				UpdateDescriptions: true,
+				Type:               "egress",
+				Grants:             describeEgress,
+			})
+		}
 		// deauthorize
 		if len(removeIngress) > 0 {
 			changes = append(changes, SecurityGroupChange{
@@ -731,7 +873,7 @@ func (s SecurityGroups) Diff() ([]SecurityGroupChange, error) {
 		}
 	}
 
-	terminal.Information("Comparison complete!")
+	information("Comparison complete!")
 
 	return changes, nil
 
@@ -766,6 +908,12 @@ func updateSecurityGroups(changes SecurityGroupChanges, dryRun bool) error {
 				if err != nil {
 					return err
 				}
+			} else if change.UpdateDescriptions {
+				// update descriptions
+				err := updateIngressDescriptions(change.Group, change.Grants, dryRun)
+				if err != nil {
+					return err
+				}
 			} else {
 				// authorize
 				err := authorizeIngress(change.Group, change.Grants, dryRun)
@@ -781,6 +929,12 @@ func updateSecurityGroups(changes SecurityGroupChanges, dryRun bool) error {
 				if err != nil {
 					return err
 				}
+			} else if change.UpdateDescriptions {
+				// update descriptions
+				err := updateEgressDescriptions(change.Group, change.Grants, dryRun)
+				if err != nil {
+					return err
+				}
 			} else {
 				// authorize
 				err := authorizeEgress(change.Group, change.Grants, dryRun)
@@ -796,17 +950,8 @@ func updateSecurityGroups(changes SecurityGroupChanges, dryRun bool) error {
 	return nil
 }
 
-func authorizeIngress(secGrp SecurityGroup, grants []config.SecurityGroupGrant, dryRun bool) error {
-
-	if len(grants) == 0 {
-		return nil
-	}
-
-	params := &ec2.AuthorizeSecurityGroupIngressInput{
-		DryRun:  aws.Bool(dryRun),
-		GroupId: aws.String(secGrp.GroupID),
-		//GroupName: aws.String(secGrp.Name),
-	}
+// buildIpPermissions converts grants into IpPermissions, the Note of each grant is sent as the rule description
+func buildIpPermissions(secGrp SecurityGroup, grants []config.SecurityGroupGrant) ([]*ec2.IpPermission, error) {
 
 	ipPermissions := []*ec2.IpPermission{}
 
@@ -814,8 +959,14 @@ func authorizeIngress(secGrp SecurityGroup, grants []config.SecurityGroupGrant,
 
 		ipRanges := []*ec2.IpRange{}
 		ipv6Ranges := []*ec2.Ipv6Range{}
+		prefixListIds := []*ec2.PrefixListId{}
 		groupPairs := []*ec2.UserIdGroupPair{}
 
+		var description *string
+		if grant.Note != "" {
+			description = aws.String(grant.Note)
+		}
+
 		for _, ip := range grant.CidrIPs {
 
 			address, _, _ := net.ParseCIDR(ip)
@@ -823,29 +974,57 @@ func authorizeIngress(secGrp SecurityGroup, grants []config.SecurityGroupGrant,
 			if govalidator.IsIPv4(address.String()) {
 				ipRanges = append(ipRanges,
 					&ec2.IpRange{
-						CidrIp: aws.String(ip),
+						CidrIp:      aws.String(ip),
+						Description: description,
 					},
 				)
 			} else if govalidator.IsIPv6(address.String()) {
 				ipv6Ranges = append(ipv6Ranges,
 					&ec2.Ipv6Range{
-						CidrIpv6: aws.String(ip),
+						CidrIpv6:    aws.String(ip),
+						Description: description,
 					},
 				)
 			} else {
-				return errors.New("IP [" + ip + "] does not appear to be a valid IPv4 or IPv6 Address. Aborting!")
+				return ipPermissions, errors.New("IP [" + ip + "] does not appear to be a valid IPv4 or IPv6 Address. Aborting!")
 			}
 		}
 
+		for _, ip := range grant.CidrIPv6s {
+
+			address, _, _ := net.ParseCIDR(ip)
+
+			if !govalidator.IsIPv6(address.String()) {
+				return ipPermissions, errors.New("IP [" + ip + "] does not appear to be a valid IPv6 Address. Aborting!")
+			}
+
+			ipv6Ranges = append(ipv6Ranges,
+				&ec2.Ipv6Range{
+					CidrIpv6:    aws.String(ip),
+					Description: description,
+				},
+			)
+		}
+
+		for _, prefixListId := range grant.PrefixListIDs {
+			prefixListIds = append(prefixListIds,
+				&ec2.PrefixListId{
+					PrefixListId: aws.String(prefixListId),
+					Description:  description,
+				},
+			)
+		}
+
 		for _, groupName := range grant.SourceSecurityGroupNames {
 			_, err := GetSecurityGroupByName(secGrp.Region, groupName)
 			if err != nil {
-				return errors.New("Security Group [" + groupName + "] does not appear to exist in [" + secGrp.Region + "]. Aborting!")
+				return ipPermissions, errors.New("Security Group [" + groupName + "] does not appear to exist in [" + secGrp.Region + "]. Aborting!")
 			}
 
 			groupPairs = append(groupPairs,
 				&ec2.UserIdGroupPair{
-					GroupName: aws.String(groupName),
+					GroupName:   aws.String(groupName),
+					Description: description,
 				},
 			)
 		}
@@ -865,18 +1044,40 @@ func authorizeIngress(secGrp SecurityGroup, grants []config.SecurityGroupGrant,
 			ipPermission.SetIpv6Ranges(ipv6Ranges)
 		}
 
+		if len(prefixListIds) > 0 {
+			ipPermission.SetPrefixListIds(prefixListIds)
+		}
+
 		if len(groupPairs) > 0 {
 			ipPermission.SetUserIdGroupPairs(groupPairs)
 		}
 
 		ipPermissions = append(ipPermissions, ipPermission)
-		params.SetIpPermissions(ipPermissions)
+	}
 
+	return ipPermissions, nil
+}
+
+func authorizeIngress(secGrp SecurityGroup, grants []config.SecurityGroupGrant, dryRun bool) error {
+
+	if len(grants) == 0 {
+		return nil
+	}
+
+	ipPermissions, err := buildIpPermissions(secGrp, grants)
+	if err != nil {
+		return err
+	}
+
+	params := &ec2.AuthorizeSecurityGroupIngressInput{
+		DryRun:        aws.Bool(dryRun),
+		GroupId:       aws.String(secGrp.GroupID),
+		IpPermissions: ipPermissions,
 	}
 
 	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(secGrp.Region)}))
 	svc := ec2.New(sess)
-	_, err := svc.AuthorizeSecurityGroupIngress(params)
+	_, err = svc.AuthorizeSecurityGroupIngress(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -897,81 +1098,20 @@ func authorizeEgress(secGrp SecurityGroup, grants []config.SecurityGroupGrant, d
 		return nil
 	}
 
-	params := &ec2.AuthorizeSecurityGroupEgressInput{
-		DryRun:  aws.Bool(dryRun),
-		GroupId: aws.String(secGrp.GroupID),
-		//GroupName: aws.String(secGrp.Name),
+	ipPermissions, err := buildIpPermissions(secGrp, grants)
+	if err != nil {
+		return err
 	}
 
-	ipPermissions := []*ec2.IpPermission{}
-
-	for _, grant := range grants {
-
-		ipRanges := []*ec2.IpRange{}
-		ipv6Ranges := []*ec2.Ipv6Range{}
-		groupPairs := []*ec2.UserIdGroupPair{}
-
-		for _, ip := range grant.CidrIPs {
-
-			address, _, _ := net.ParseCIDR(ip)
-
-			if govalidator.IsIPv4(address.String()) {
-				ipRanges = append(ipRanges,
-					&ec2.IpRange{
-						CidrIp: aws.String(ip),
-					},
-				)
-			} else if govalidator.IsIPv6(address.String()) {
-				ipv6Ranges = append(ipv6Ranges,
-					&ec2.Ipv6Range{
-						CidrIpv6: aws.String(ip),
-					},
-				)
-			} else {
-				return errors.New("IP [" + ip + "] does not appear to be a valid IPv4 or IPv6 Address. Aborting!")
-			}
-		}
-
-		for _, groupName := range grant.SourceSecurityGroupNames {
-			_, err := GetSecurityGroupByName(secGrp.Region, groupName)
-			if err != nil {
-				return errors.New("Security Group [" + groupName + "] does not appear to exist in [" + secGrp.Region + "]. Aborting!")
-			}
-
-			groupPairs = append(groupPairs,
-				&ec2.UserIdGroupPair{
-					GroupName: aws.String(groupName),
-				},
-			)
-		}
-
-		ipPermission := &ec2.IpPermission{}
-
-		ipPermission.
-			SetIpProtocol(grant.IPProtocol).
-			SetFromPort(int64(grant.FromPort)).
-			SetToPort(int64(grant.ToPort))
-
-		if len(ipRanges) > 0 {
-			ipPermission.SetIpRanges(ipRanges)
-		}
-
-		if len(ipv6Ranges) > 0 {
-			ipPermission.SetIpv6Ranges(ipv6Ranges)
-		}
-
-		if len(groupPairs) > 0 {
-			ipPermission.SetUserIdGroupPairs(groupPairs)
-		}
-
-		ipPermissions = append(ipPermissions, ipPermission)
-		params.SetIpPermissions(ipPermissions)
-
+	params := &ec2.AuthorizeSecurityGroupEgressInput{
+		DryRun:        aws.Bool(dryRun),
+		GroupId:       aws.String(secGrp.GroupID),
+		IpPermissions: ipPermissions,
 	}
 
 	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(secGrp.Region)}))
 	svc := ec2.New(sess)
-	_, err := svc.AuthorizeSecurityGroupEgress(params)
+	_, err = svc.AuthorizeSecurityGroupEgress(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -992,81 +1132,54 @@ func revokeIngress(secGrp SecurityGroup, grants []config.SecurityGroupGrant, dry
 		return nil
 	}
 
-	params := &ec2.RevokeSecurityGroupIngressInput{
-		DryRun:  aws.Bool(dryRun),
-		GroupId: aws.String(secGrp.GroupID),
-		//GroupName: aws.String(secGrp.Name),
+	ipPermissions, err := buildIpPermissions(secGrp, grants)
+	if err != nil {
+		return err
 	}
 
-	ipPermissions := []*ec2.IpPermission{}
-
-	for _, grant := range grants {
-
-		ipRanges := []*ec2.IpRange{}
-		ipv6Ranges := []*ec2.Ipv6Range{}
-		groupPairs := []*ec2.UserIdGroupPair{}
-
-		for _, ip := range grant.CidrIPs {
-
-			address, _, _ := net.ParseCIDR(ip)
+	params := &ec2.RevokeSecurityGroupIngressInput{
+		DryRun:        aws.Bool(dryRun),
+		GroupId:       aws.String(secGrp.GroupID),
+		IpPermissions: ipPermissions,
+	}
 
-			if govalidator.IsIPv4(address.String()) {
-				ipRanges = append(ipRanges,
-					&ec2.IpRange{
-						CidrIp: aws.String(ip),
-					},
-				)
-			} else if govalidator.IsIPv6(address.String()) {
-				ipv6Ranges = append(ipv6Ranges,
-					&ec2.Ipv6Range{
-						CidrIpv6: aws.String(ip),
-					},
-				)
-			} else {
-				return errors.New("IP [" + ip + "] does not appear to be a valid IPv4 or IPv6 Address. Aborting!")
-			}
-		}
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(secGrp.Region)}))
+	svc := ec2.New(sess)
+	_, err = svc.RevokeSecurityGroupIngress(params)
 
-		for _, groupName := range grant.SourceSecurityGroupNames {
-			_, err := GetSecurityGroupByName(secGrp.Region, groupName)
-			if err != nil {
-				return errors.New("Security Group [" + groupName + "] does not appear to exist in [" + secGrp.Region + "]. Aborting!")
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == "DryRunOperation" {
+				return nil
 			}
-
-			groupPairs = append(groupPairs,
-				&ec2.UserIdGroupPair{
-					GroupName: aws.String(groupName),
-				},
-			)
+			return errors.New(awsErr.Message())
 		}
+		return err
+	}
 
-		ipPermission := &ec2.IpPermission{}
-
-		ipPermission.
-			SetIpProtocol(grant.IPProtocol).
-			SetFromPort(int64(grant.FromPort)).
-			SetToPort(int64(grant.ToPort))
-
-		if len(ipRanges) > 0 {
-			ipPermission.SetIpRanges(ipRanges)
-		}
+	return nil
+}
 
-		if len(ipv6Ranges) > 0 {
-			ipPermission.SetIpv6Ranges(ipv6Ranges)
-		}
+func revokeEgress(secGrp SecurityGroup, grants []config.SecurityGroupGrant, dryRun bool) error {
 
-		if len(groupPairs) > 0 {
-			ipPermission.SetUserIdGroupPairs(groupPairs)
-		}
+	if len(grants) == 0 {
+		return nil
+	}
 
-		ipPermissions = append(ipPermissions, ipPermission)
-		params.SetIpPermissions(ipPermissions)
+	ipPermissions, err := buildIpPermissions(secGrp, grants)
+	if err != nil {
+		return err
+	}
 
+	params := &ec2.RevokeSecurityGroupEgressInput{
+		DryRun:        aws.Bool(dryRun),
+		GroupId:       aws.String(secGrp.GroupID),
+		IpPermissions: ipPermissions,
 	}
 
 	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(secGrp.Region)}))
 	svc := ec2.New(sess)
-	_, err := svc.RevokeSecurityGroupIngress(params)
+	_, err = svc.RevokeSecurityGroupEgress(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -1081,87 +1194,60 @@ func revokeIngress(secGrp SecurityGroup, grants []config.SecurityGroupGrant, dry
 	return nil
 }
 
-func revokeEgress(secGrp SecurityGroup, grants []config.SecurityGroupGrant, dryRun bool) error {
+func updateIngressDescriptions(secGrp SecurityGroup, grants []config.SecurityGroupGrant, dryRun bool) error {
 
 	if len(grants) == 0 {
 		return nil
 	}
 
-	params := &ec2.RevokeSecurityGroupEgressInput{
-		DryRun:  aws.Bool(dryRun),
-		GroupId: aws.String(secGrp.GroupID),
-		//GroupName: aws.String(secGrp.Name),
+	ipPermissions, err := buildIpPermissions(secGrp, grants)
+	if err != nil {
+		return err
 	}
 
-	ipPermissions := []*ec2.IpPermission{}
-
-	for _, grant := range grants {
-
-		ipRanges := []*ec2.IpRange{}
-		ipv6Ranges := []*ec2.Ipv6Range{}
-		groupPairs := []*ec2.UserIdGroupPair{}
-
-		for _, ip := range grant.CidrIPs {
-
-			address, _, _ := net.ParseCIDR(ip)
+	params := &ec2.UpdateSecurityGroupRuleDescriptionsIngressInput{
+		DryRun:        aws.Bool(dryRun),
+		GroupId:       aws.String(secGrp.GroupID),
+		IpPermissions: ipPermissions,
+	}
 
-			if govalidator.IsIPv4(address.String()) {
-				ipRanges = append(ipRanges,
-					&ec2.IpRange{
-						CidrIp: aws.String(ip),
-					},
-				)
-			} else if govalidator.IsIPv6(address.String()) {
-				ipv6Ranges = append(ipv6Ranges,
-					&ec2.Ipv6Range{
-						CidrIpv6: aws.String(ip),
-					},
-				)
-			} else {
-				return errors.New("IP [" + ip + "] does not appear to be a valid IPv4 or IPv6 Address. Aborting!")
-			}
-		}
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(secGrp.Region)}))
+	svc := ec2.New(sess)
+	_, err = svc.UpdateSecurityGroupRuleDescriptionsIngress(params)
 
-		for _, groupName := range grant.SourceSecurityGroupNames {
-			_, err := GetSecurityGroupByName(secGrp.Region, groupName)
-			if err != nil {
-				return errors.New("Security Group [" + groupName + "] does not appear to exist in [" + secGrp.Region + "]. Aborting!")
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == "DryRunOperation" {
+				return nil
 			}
-
-			groupPairs = append(groupPairs,
-				&ec2.UserIdGroupPair{
-					GroupName: aws.String(groupName),
-				},
-			)
+			return errors.New(awsErr.Message())
 		}
+		return err
+	}
 
-		ipPermission := &ec2.IpPermission{}
-
-		ipPermission.
-			SetIpProtocol(grant.IPProtocol).
-			SetFromPort(int64(grant.FromPort)).
-			SetToPort(int64(grant.ToPort))
-
-		if len(ipRanges) > 0 {
-			ipPermission.SetIpRanges(ipRanges)
-		}
+	return nil
+}
 
-		if len(ipv6Ranges) > 0 {
-			ipPermission.SetIpv6Ranges(ipv6Ranges)
-		}
+func updateEgressDescriptions(secGrp SecurityGroup, grants []config.SecurityGroupGrant, dryRun bool) error {
 
-		if len(groupPairs) > 0 {
-			ipPermission.SetUserIdGroupPairs(groupPairs)
-		}
+	if len(grants) == 0 {
+		return nil
+	}
 
-		ipPermissions = append(ipPermissions, ipPermission)
-		params.SetIpPermissions(ipPermissions)
+	ipPermissions, err := buildIpPermissions(secGrp, grants)
+	if err != nil {
+		return err
+	}
 
+	params := &ec2.UpdateSecurityGroupRuleDescriptionsEgressInput{
+		DryRun:        aws.Bool(dryRun),
+		GroupId:       aws.String(secGrp.GroupID),
+		IpPermissions: ipPermissions,
 	}
 
 	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(secGrp.Region)}))
 	svc := ec2.New(sess)
-	_, err := svc.RevokeSecurityGroupEgress(params)
+	_, err = svc.UpdateSecurityGroupRuleDescriptionsEgress(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -0,0 +1,267 @@
+package aws
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/murdinc/terminal"
+)
+
+// ShareImage grants (or revokes) launch permissions on the AMI Images matching the provided search to a comma separated list of AWS Account IDs
+func ShareImage(search, accounts string, revoke, dryRun bool) error {
+
+	// --dry-run flag
+	if dryRun {
+		terminal.Information("--dry-run flag is set, not making any actual changes!")
+	}
+
+	accountIds, err := parseAccountIds(accounts)
+	if err != nil {
+		return err
+	}
+
+	images, _ := GetImages(search, true)
+	if len(*images) == 0 {
+		return errors.New("No available images found for your search terms.")
+	}
+
+	images.PrintTable()
+
+	// Confirm
+	action := "share these Images with"
+	if revoke {
+		action = "revoke the launch permissions on these Images from"
+	}
+	if !terminal.PromptBool("Are you sure you want to " + action + " [" + strings.Join(accountIds, ", ") + "]?") {
+		return errors.New("Aborting!")
+	}
+
+	for _, image := range *images {
+		err := shareImage(image.ImageID, image.Region, accountIds, revoke, dryRun)
+		if err != nil {
+			return err
+		}
+	}
+
+	terminal.Information("Done!")
+
+	return nil
+}
+
+// ShareSnapshot grants (or revokes) createVolume permissions on the EBS Snapshots matching the provided search to a comma separated list of AWS Account IDs
+func ShareSnapshot(search, accounts string, revoke, dryRun bool) error {
+
+	// --dry-run flag
+	if dryRun {
+		terminal.Information("--dry-run flag is set, not making any actual changes!")
+	}
+
+	accountIds, err := parseAccountIds(accounts)
+	if err != nil {
+		return err
+	}
+
+	snapshots, _ := GetSnapshots(search, true)
+	if len(*snapshots) == 0 {
+		return errors.New("No completed snapshots found for your search terms.")
+	}
+
+	snapshots.PrintTable()
+
+	// Confirm
+	action := "share these Snapshots with"
+	if revoke {
+		action = "revoke the createVolume permissions on these Snapshots from"
+	}
+	if !terminal.PromptBool("Are you sure you want to " + action + " [" + strings.Join(accountIds, ", ") + "]?") {
+		return errors.New("Aborting!")
+	}
+
+	for _, snapshot := range *snapshots {
+		err := shareSnapshot(snapshot.SnapshotID, snapshot.Region, accountIds, revoke, dryRun)
+		if err != nil {
+			return err
+		}
+	}
+
+	terminal.Information("Done!")
+
+	return nil
+}
+
+// parseAccountIds splits and validates a comma separated list of AWS Account IDs
+func parseAccountIds(accounts string) ([]string, error) {
+	var accountIds []string
+
+	for _, account := range strings.Split(accounts, ",") {
+		account = strings.TrimSpace(account)
+		if account == "" {
+			continue
+		}
+		if !regexp.MustCompile(`^\d{12}$`).MatchString(account) {
+			return accountIds, errors.New("[" + account + "] is not a valid AWS Account ID!")
+		}
+		accountIds = append(accountIds, account)
+	}
+
+	if len(accountIds) == 0 {
+		return accountIds, errors.New("No AWS Account IDs provided!")
+	}
+
+	return accountIds, nil
+}
+
+// private function without terminal prompts
+func shareImage(imageID, region string, accountIds []string, revoke, dryRun bool) error {
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := ec2.New(sess)
+
+	permissions := make([]*ec2.LaunchPermission, len(accountIds))
+	for i, accountId := range accountIds {
+		permissions[i] = &ec2.LaunchPermission{UserId: aws.String(accountId)}
+	}
+
+	modifications := &ec2.LaunchPermissionModifications{}
+	if revoke {
+		modifications.SetRemove(permissions)
+	} else {
+		modifications.SetAdd(permissions)
+	}
+
+	params := &ec2.ModifyImageAttributeInput{
+		ImageId:          aws.String(imageID),
+		LaunchPermission: modifications,
+		DryRun:           aws.Bool(dryRun),
+	}
+
+	_, err := svc.ModifyImageAttribute(params)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == "DryRunOperation" {
+				return nil
+			}
+			return errors.New(awsErr.Message())
+		}
+		return err
+	}
+
+	if revoke {
+		terminal.Delta("Revoked launch permissions on Image [" + imageID + "] in [" + region + "] from [" + strings.Join(accountIds, ", ") + "]!")
+	} else {
+		terminal.Delta("Shared Image [" + imageID + "] in [" + region + "] with [" + strings.Join(accountIds, ", ") + "]!")
+	}
+
+	return nil
+}
+
+// private function without terminal prompts
+func shareSnapshot(snapshotID, region string, accountIds []string, revoke, dryRun bool) error {
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := ec2.New(sess)
+
+	permissions := make([]*ec2.CreateVolumePermission, len(accountIds))
+	for i, accountId := range accountIds {
+		permissions[i] = &ec2.CreateVolumePermission{UserId: aws.String(accountId)}
+	}
+
+	modifications := &ec2.CreateVolumePermissionModifications{}
+	if revoke {
+		modifications.SetRemove(permissions)
+	} else {
+		modifications.SetAdd(permissions)
+	}
+
+	params := &ec2.ModifySnapshotAttributeInput{
+		SnapshotId:             aws.String(snapshotID),
+		CreateVolumePermission: modifications,
+		DryRun:                 aws.Bool(dryRun),
+	}
+
+	_, err := svc.ModifySnapshotAttribute(params)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == "DryRunOperation" {
+				return nil
+			}
+			return errors.New(awsErr.Message())
+		}
+		return err
+	}
+
+	if revoke {
+		terminal.Delta("Revoked createVolume permissions on Snapshot [" + snapshotID + "] in [" + region + "] from [" + strings.Join(accountIds, ", ") + "]!")
+	} else {
+		terminal.Delta("Shared Snapshot [" + snapshotID + "] in [" + region + "] with [" + strings.Join(accountIds, ", ") + "]!")
+	}
+
+	return nil
+}
+
+// revokeImageLaunchPermissions revokes all account launch permissions on an Image
+func revokeImageLaunchPermissions(imageID, region string, dryRun bool) error {
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := ec2.New(sess)
+
+	resp, err := svc.DescribeImageAttribute(&ec2.DescribeImageAttributeInput{
+		ImageId:   aws.String(imageID),
+		Attribute: aws.String("launchPermission"),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return errors.New(awsErr.Message())
+		}
+		return err
+	}
+
+	var accountIds []string
+	for _, permission := range resp.LaunchPermissions {
+		if aws.StringValue(permission.UserId) != "" {
+			accountIds = append(accountIds, aws.StringValue(permission.UserId))
+		}
+	}
+
+	if len(accountIds) == 0 {
+		return nil
+	}
+
+	return shareImage(imageID, region, accountIds, true, dryRun)
+}
+
+// revokeSnapshotVolumePermissions revokes all account createVolume permissions on a Snapshot
+func revokeSnapshotVolumePermissions(snapshotID, region string, dryRun bool) error {
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := ec2.New(sess)
+
+	resp, err := svc.DescribeSnapshotAttribute(&ec2.DescribeSnapshotAttributeInput{
+		SnapshotId: aws.String(snapshotID),
+		Attribute:  aws.String("createVolumePermission"),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return errors.New(awsErr.Message())
+		}
+		return err
+	}
+
+	var accountIds []string
+	for _, permission := range resp.CreateVolumePermissions {
+		if aws.StringValue(permission.UserId) != "" {
+			accountIds = append(accountIds, aws.StringValue(permission.UserId))
+		}
+	}
+
+	if len(accountIds) == 0 {
+		return nil
+	}
+
+	return shareSnapshot(snapshotID, region, accountIds, true, dryRun)
+}
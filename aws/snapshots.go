@@ -136,6 +136,7 @@ func (s *Snapshot) Marshal(snapshot *ec2.Snapshot, region string) {
 	s.StartTime = *snapshot.StartTime
 	s.Progress = aws.StringValue(snapshot.Progress)
 	s.VolumeSize = fmt.Sprint(aws.Int64Value(snapshot.VolumeSize))
+	s.Encrypted = aws.BoolValue(snapshot.Encrypted)
 	s.Region = region
 
 	switch s.State {
@@ -196,7 +197,7 @@ func GetRegionSnapshots(region string, snapList *Snapshots, search string, compl
 }
 
 // CopySnapshot copies a Snapshot to another region
-func CopySnapshot(search, region string, dryRun bool) error {
+func CopySnapshot(search, region string, encrypt bool, kmsKeyId string, dryRun bool) error {
 
 	// --dry-run flag
 	if dryRun {
@@ -221,7 +222,7 @@ func CopySnapshot(search, region string, dryRun bool) error {
 
 	snapshot := (*snapshots)[0]
 
-	_, err := copySnapshot(snapshot, region, dryRun)
+	_, err := copySnapshot(snapshot, region, encrypt, kmsKeyId, dryRun)
 	if err != nil {
 		return err
 	}
@@ -232,7 +233,7 @@ func CopySnapshot(search, region string, dryRun bool) error {
 }
 
 // private function without terminal prompts
-func copySnapshot(snapshot Snapshot, region string, dryRun bool) (string, error) {
+func copySnapshot(snapshot Snapshot, region string, encrypt bool, kmsKeyId string, dryRun bool) (string, error) {
 
 	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
 	svc := ec2.New(sess)
@@ -243,22 +244,31 @@ func copySnapshot(snapshot Snapshot, region string, dryRun bool) (string, error)
 		Description:      aws.String(snapshot.Description),
 		SourceRegion:     aws.String(snapshot.Region),
 		DryRun:           aws.Bool(dryRun),
-		//Encrypted:         aws.Bool(true),
-		//KmsKeyId:          aws.String("String"),
 		//PresignedUrl:      aws.String("String"),
 		//DestinationRegion: aws.String(region), // only needed when using presigned url, bombs otherwise
 
 	}
 
-	copySnapResp, err := svc.CopySnapshot(params)
-	newSnapshotId := aws.StringValue(copySnapResp.SnapshotId)
+	if encrypt {
+		params.SetEncrypted(true)
+		if kmsKeyId != "" {
+			params.SetKmsKeyId(kmsKeyId)
+		}
+	}
 
+	copySnapResp, err := svc.CopySnapshot(params)
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
-			return newSnapshotId, errors.New(awsErr.Message())
+			if awsErr.Code() == "DryRunOperation" {
+				return "", nil
+			}
+			return "", errors.New(awsErr.Message())
 		}
+		return "", err
 	}
 
+	newSnapshotId := aws.StringValue(copySnapResp.SnapshotId)
+
 	// Add Tags
 	err = SetEc2NameAndClassTags(&newSnapshotId, snapshot.Name, snapshot.Class, region)
 
@@ -274,6 +284,153 @@ func copySnapshot(snapshot Snapshot, region string, dryRun bool) (string, error)
 	return newSnapshotId, err
 }
 
+// EncryptSnapshot re-copies unencrypted EBS Snapshots matching the provided search as encrypted snapshots in the same region, keeping their tags,
+// and only deletes the originals when asked to
+func EncryptSnapshot(search, kmsKeyId string, deleteOriginals, dryRun bool) error {
+
+	// --dry-run flag
+	if dryRun {
+		terminal.Information("--dry-run flag is set, not making any actual changes!")
+	}
+
+	snapshots, errs := GetSnapshots(search, true)
+	if len(errs) > 0 {
+		return errors.New("Error gathering Snapshot list")
+	}
+
+	var snapList Snapshots
+	for _, snapshot := range *snapshots {
+		if snapshot.Encrypted {
+			terminal.Information("Snapshot [" + snapshot.SnapshotID + "] named [" + snapshot.Name + "] in [" + snapshot.Region + "] is already encrypted, skipping!")
+			continue
+		}
+		snapList = append(snapList, snapshot)
+	}
+
+	if len(snapList) == 0 {
+		return errors.New("No unencrypted snapshots found for your search terms.")
+	}
+
+	snapList.PrintTable()
+
+	// Confirm
+	if !deleteOriginals && !terminal.PromptBool("Are you sure you want to encrypt these Snapshots? The unencrypted originals will be kept.") {
+		return errors.New("Aborting!")
+	}
+	if deleteOriginals && !terminal.PromptBool("Are you sure you want to encrypt these Snapshots and DELETE the unencrypted originals?") {
+		return errors.New("Aborting!")
+	}
+
+	launchConfigs, err := GetLaunchConfigurations("")
+	if err != nil {
+		return errors.New("Error while retrieving the list of snapshots in use by launch configurations!")
+	}
+	lockedSnapshots := launchConfigs.LockedSnapshotIds()
+
+	// Snapshots backing AMIs can not be deleted either
+	imageRegions := make(map[string]bool)
+	for _, snapshot := range snapList {
+		if imageRegions[snapshot.Region] {
+			continue
+		}
+		imageRegions[snapshot.Region] = true
+
+		err := lockImageSnapshotIds(snapshot.Region, lockedSnapshots)
+		if err != nil {
+			return errors.New("Error while retrieving the list of snapshots in use by images in [" + snapshot.Region + "]: " + err.Error())
+		}
+	}
+
+	for _, snapshot := range snapList {
+
+		// Fall back to the KMS Key of the snapshot class in this region
+		keyId := kmsKeyId
+		if keyId == "" && snapshot.Class != "" {
+			snapCfg, err := config.LoadSnapshotClass(snapshot.Class)
+			if err == nil {
+				keyId = snapCfg.KmsKeyIds[snapshot.Region]
+			}
+		}
+
+		err := encryptSnapshot(snapshot, keyId, lockedSnapshots[snapshot.SnapshotID], deleteOriginals, dryRun)
+		if err != nil {
+			return err
+		}
+	}
+
+	terminal.Information("Done!")
+
+	return nil
+}
+
+// lockImageSnapshotIds adds the EBS Snapshots of every AMI owned by this account in a region to a map of locked snapshots
+func lockImageSnapshotIds(region string, locked map[string]bool) error {
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := ec2.New(sess)
+
+	resp, err := svc.DescribeImages(&ec2.DescribeImagesInput{Owners: []*string{aws.String("self")}})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return errors.New(awsErr.Message())
+		}
+		return err
+	}
+
+	for _, image := range resp.Images {
+		for _, mapping := range image.BlockDeviceMappings {
+			if mapping.Ebs != nil && aws.StringValue(mapping.Ebs.SnapshotId) != "" {
+				locked[aws.StringValue(mapping.Ebs.SnapshotId)] = true
+			}
+		}
+	}
+
+	return nil
+}
+
+// private function without terminal prompts
+func encryptSnapshot(snapshot Snapshot, kmsKeyId string, locked, deleteOriginal, dryRun bool) error {
+
+	newSnapshotId, err := copySnapshot(snapshot, snapshot.Region, true, kmsKeyId, dryRun)
+	if err != nil {
+		return err
+	}
+
+	// Nothing was copied during a dry run
+	if dryRun {
+		return nil
+	}
+
+	// Keep the rest of the tags of the original
+	err = copyEc2Tags(snapshot.SnapshotID, newSnapshotId, snapshot.Region)
+	if err != nil {
+		return err
+	}
+
+	terminal.Notice(fmt.Sprintf("Waiting for encrypted snapshot [%s] to complete...", newSnapshotId))
+	err = waitForSnapshot(newSnapshotId, snapshot.Region, dryRun)
+	if err != nil {
+		return err
+	}
+	terminal.Delta(fmt.Sprintf("Encrypted snapshot [%s] of [%s] in [%s] has completed!", newSnapshotId, snapshot.SnapshotID, snapshot.Region))
+
+	if !deleteOriginal {
+		return nil
+	}
+
+	if locked {
+		terminal.Notice("Snapshot [" + snapshot.SnapshotID + "] named [" + snapshot.Name + "] is being used in a launch configuration or image, not deleting it!")
+		return nil
+	}
+
+	err = revokeSnapshotVolumePermissions(snapshot.SnapshotID, snapshot.Region, dryRun)
+	if err != nil {
+		return err
+	}
+
+	return deleteSnapshots(&Snapshots{snapshot}, dryRun)
+}
+
 // CreateSnapshot creates a new EBS Snapshot
 func CreateSnapshot(class, search string, waitFlag, forceYes, dryRun bool) error {
 
@@ -353,7 +510,7 @@ func CreateSnapshot(class, search string, waitFlag, forceYes, dryRun bool) error
 				terminal.ErrorLine(err.Error() + " No SSM pre/post SnapshotCommands will be run on this instance!")
 
 				// Confirm continue if we can't run them.
-				if !terminal.PromptBool("Do you want to continue without running any pre/post Snapshot scripts?") {
+				if !forceYes && !terminal.PromptBool("Do you want to continue without running any pre/post Snapshot scripts?") {
 					return errors.New("Aborting!")
 				}
 			} else {
@@ -394,6 +551,7 @@ func CreateSnapshot(class, search string, waitFlag, forceYes, dryRun bool) error
 	if snapCfg.Propagate && snapCfg.PropagateRegions != nil {
 
 		var wg sync.WaitGroup
+		var mu sync.Mutex
 		var errs []error
 
 		terminal.Notice("Propagate flag is set, waiting for initial snapshot to complete...")
@@ -414,11 +572,13 @@ func CreateSnapshot(class, search string, waitFlag, forceYes, dryRun bool) error
 					defer wg.Done()
 
 					// Copy snapshot to the destination region
-					newSnapshotId, err := copySnapshot(sourceSnapshot, propRegion, dryRun)
+					newSnapshotId, err := copySnapshot(sourceSnapshot, propRegion, snapCfg.Encrypt, snapCfg.KmsKeyIds[propRegion], dryRun)
 
 					if err != nil {
 						terminal.ShowErrorMessage(fmt.Sprintf("Error propagating snapshot [%s] to region [%s]", sourceSnapshot.SnapshotID, propRegion), err.Error())
+						mu.Lock()
 						errs = append(errs, err)
+						mu.Unlock()
 					} else {
 						// Add Tags
 						SetEc2NameAndClassTags(&newSnapshotId, name, class, propRegion)
@@ -426,12 +586,28 @@ func CreateSnapshot(class, search string, waitFlag, forceYes, dryRun bool) error
 
 					}
 
+					// Share the copy once it completes
+					if err == nil && len(snapCfg.ShareAccounts) > 0 {
+						err = waitForSnapshot(newSnapshotId, propRegion, dryRun)
+						if err == nil {
+							err = shareSnapshot(newSnapshotId, propRegion, snapCfg.ShareAccounts, false, dryRun)
+						}
+						if err != nil {
+							terminal.ShowErrorMessage(fmt.Sprintf("Error sharing snapshot [%s] in region [%s]", newSnapshotId, propRegion), err.Error())
+							mu.Lock()
+							errs = append(errs, err)
+							mu.Unlock()
+						}
+					}
+
 					if waitFlag {
 						// Wait for the snapshot to complete.
 						terminal.Notice(fmt.Sprintf("Waiting for snapshot [%s] to complete...", newSnapshotId))
 						err = waitForSnapshot(newSnapshotId, propRegion, dryRun)
 						if err != nil {
+							mu.Lock()
 							errs = append(errs, err)
+							mu.Unlock()
 						}
 						terminal.Delta(fmt.Sprintf("Snapshot [%s] in [%s] has completed!", newSnapshotId, propRegion))
 					}
@@ -456,6 +632,21 @@ func CreateSnapshot(class, search string, waitFlag, forceYes, dryRun bool) error
 		terminal.Delta(fmt.Sprintf("Snapshot [%s] completed!", newSnapshotId))
 	}
 
+	// Share the new snapshot with other accounts
+	if len(snapCfg.ShareAccounts) > 0 {
+		terminal.Notice("Share Accounts are set, waiting for snapshot to complete...")
+
+		err = waitForSnapshot(newSnapshotId, region, dryRun)
+		if err != nil {
+			return err
+		}
+
+		err = shareSnapshot(newSnapshotId, region, snapCfg.ShareAccounts, false, dryRun)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Rotate out older snapshots
 	if snapCfg.Rotate && snapCfg.Retain > 1 {
 		terminal.Notice("Rotate flag is set, looking for snapshots to rotate...")
@@ -523,6 +714,7 @@ func rotateSnapshots(class string, cfg config.SnapshotClass, dryRun bool) error
 	}
 
 	var wg sync.WaitGroup
+	var mu sync.Mutex
 	var errs []error
 
 	launchConfigs, err := GetLaunchConfigurations("")
@@ -543,7 +735,9 @@ func rotateSnapshots(class string, cfg config.SnapshotClass, dryRun bool) error
 			snapshots, err := GetSnapshotsByTag(*region.RegionName, "Class", class, true)
 			if err != nil {
 				terminal.ShowErrorMessage(fmt.Sprintf("Error gathering snapshot list for region [%s]", *region.RegionName), err.Error())
+				mu.Lock()
 				errs = append(errs, err)
+				mu.Unlock()
 			}
 
 			var unlockedSnapshots Snapshots
@@ -560,7 +754,21 @@ func rotateSnapshots(class string, cfg config.SnapshotClass, dryRun bool) error
 			// Delete the oldest ones if we have more than the retention number
 			if len(unlockedSnapshots) > cfg.Retain {
 				sort.Sort(unlockedSnapshots) // important!
-				ds := unlockedSnapshots[cfg.Retain:]
+				var ds Snapshots
+
+				// Revoke any createVolume permissions before deleting, keeping snapshots that are still shared
+				for _, snap := range unlockedSnapshots[cfg.Retain:] {
+					err := revokeSnapshotVolumePermissions(snap.SnapshotID, snap.Region, dryRun)
+					if err != nil {
+						terminal.ShowErrorMessage(fmt.Sprintf("Error revoking createVolume permissions on snapshot [%s] in region [%s], skipping!", snap.SnapshotID, snap.Region), err.Error())
+						mu.Lock()
+						errs = append(errs, err)
+						mu.Unlock()
+						continue
+					}
+					ds = append(ds, snap)
+				}
+
 				deleteSnapshots(&ds, dryRun)
 			}
 
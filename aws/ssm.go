@@ -6,14 +6,17 @@ import (
 	"os"
 	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	humanize "github.com/dustin/go-humanize"
+	"github.com/murdinc/awsm/config"
 	"github.com/murdinc/awsm/models"
 	"github.com/murdinc/terminal"
 	"github.com/olekukonko/tablewriter"
@@ -314,14 +317,77 @@ func GetRegionCommandInvocationsByCommandID(region string, commandId string, det
 	return cmdInvocations, nil
 }
 
-// RunCommand runs a command on one or more ec2 instances.
-func RunCommand(search, command string, dryRun bool) (*CommandInvocations, error) {
+// commandSpec describes an SSM Document to run on instances, along with its untyped parameters
+type commandSpec struct {
+	Document          string
+	Parameters        map[string][]string
+	TimeoutSeconds    int
+	WorkingDirectory  string
+	OutputS3Bucket    string
+	OutputS3KeyPrefix string
+	Description       string
+}
+
+// RunCommand runs a command, a Command class or any SSM Document on one or more ec2 instances. Parameters are passed as key=value strings.
+func RunCommand(search, command, class, document string, parameters []string, dryRun bool) (*CommandInvocations, error) {
 
 	// --dry-run flag
 	if dryRun {
 		terminal.Information("--dry-run flag is set, not making any actual changes!")
 	}
 
+	spec := commandSpec{
+		Document:   "AWS-RunShellScript",
+		Parameters: make(map[string][]string),
+	}
+
+	// Command Class
+	if class != "" {
+		cfg, err := config.LoadCommandClass(class)
+		if err != nil {
+			return &CommandInvocations{}, err
+		}
+
+		terminal.Information("Found Command Class Configuration for [" + class + "]!")
+
+		if cfg.Document != "" {
+			spec.Document = cfg.Document
+		}
+		if cfg.Script != "" {
+			spec.Parameters["commands"] = []string{cfg.Script}
+		}
+		err = addCommandParameters(spec.Parameters, cfg.Parameters)
+		if err != nil {
+			return &CommandInvocations{}, err
+		}
+		spec.TimeoutSeconds = cfg.TimeoutSeconds
+		spec.WorkingDirectory = cfg.WorkingDirectory
+		spec.OutputS3Bucket = cfg.OutputS3Bucket
+		spec.OutputS3KeyPrefix = cfg.OutputS3KeyPrefix
+		spec.Description = "class [" + class + "]"
+	}
+
+	// Flags and arguments override the class
+	if document != "" {
+		spec.Document = document
+	}
+	if command != "" {
+		spec.Parameters["commands"] = []string{command}
+		spec.Description = command
+	}
+	err := addCommandParameters(spec.Parameters, parameters)
+	if err != nil {
+		return &CommandInvocations{}, err
+	}
+
+	if spec.Description == "" {
+		spec.Description = "document [" + spec.Document + "]"
+	}
+
+	if spec.Document == "AWS-RunShellScript" && len(spec.Parameters["commands"]) == 0 {
+		return &CommandInvocations{}, errors.New("No command provided! Pass a command argument, a Command class or a Document.")
+	}
+
 	instList, errs := GetSSMInstances(search)
 	if errs != nil {
 		return &CommandInvocations{}, errors.New("Error gathering Instance list")
@@ -335,12 +401,12 @@ func RunCommand(search, command string, dryRun bool) (*CommandInvocations, error
 	}
 
 	// Confirm
-	if !terminal.PromptBool("Are you sure you want to run the command [" + command + "] on these instances?") {
+	if !terminal.PromptBool("Are you sure you want to run the " + spec.Description + " on these instances?") {
 		return &CommandInvocations{}, errors.New("Aborting!")
 	}
 
 	// Run Em
-	commandInvocations, err := runCommand(instList, command, dryRun)
+	commandInvocations, err := sendCommand(instList, spec, dryRun)
 	if err != nil {
 		return commandInvocations, err
 	}
@@ -350,8 +416,38 @@ func RunCommand(search, command string, dryRun bool) (*CommandInvocations, error
 	return commandInvocations, nil
 }
 
+// addCommandParameters parses key=value strings into a parameter map, repeated keys build up a list
+func addCommandParameters(parameters map[string][]string, keyValues []string) error {
+	overridden := make(map[string]bool)
+
+	for _, keyValue := range keyValues {
+		parts := strings.SplitN(keyValue, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return errors.New("Parameter [" + keyValue + "] is invalid, parameters must be in the format key=value")
+		}
+
+		// Replace any values set before this list, and append within it
+		if !overridden[parts[0]] {
+			parameters[parts[0]] = nil
+			overridden[parts[0]] = true
+		}
+		parameters[parts[0]] = append(parameters[parts[0]], parts[1])
+	}
+
+	return nil
+}
+
 // private function without the confirmation terminal prompts
 func runCommand(instList *SSMInstances, command string, dryRun bool) (*CommandInvocations, error) {
+	return sendCommand(instList, commandSpec{
+		Document:    "AWS-RunShellScript",
+		Parameters:  map[string][]string{"commands": {command}},
+		Description: command,
+	}, dryRun)
+}
+
+// private function without the confirmation terminal prompts
+func sendCommand(instList *SSMInstances, spec commandSpec, dryRun bool) (*CommandInvocations, error) {
 
 	regionInstanceIds := make(map[string][]string)
 	regionInstanceNames := make(map[string][]string)
@@ -363,41 +459,64 @@ func runCommand(instList *SSMInstances, command string, dryRun bool) (*CommandIn
 
 	cmdInvocationsCombined := new(CommandInvocations)
 
-	// Bail if on a dryRun
-	// TODO more
-	if dryRun {
-		return cmdInvocationsCombined, nil
-	}
-
 	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []string
+
 	for region, instanceIds := range regionInstanceIds {
 		wg.Add(1)
 
-		terminal.Delta("Sending Command [" + command + "] to instances [" + strings.Join(regionInstanceNames[region], ", ") + "] in [" + region + "]!")
-
-		go func(region string, instanceIds []string, command string) {
+		go func(region string, instanceIds []string) {
 			defer wg.Done()
 			sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
 			svc := ssm.New(sess)
 
+			// Type the parameters against the document in this region
+			documentType, parameters, err := buildDocumentParameters(svc, spec)
+			if err != nil {
+				terminal.ErrorLine(err.Error() + " in [" + region + "]")
+				mu.Lock()
+				errs = append(errs, region)
+				mu.Unlock()
+				return
+			}
+
+			// Bail if on a dryRun
+			if dryRun {
+				terminal.Notice("Would have sent [" + documentType + "] Document [" + spec.Document + "] to instances [" + strings.Join(regionInstanceNames[region], ", ") + "] in [" + region + "]")
+				return
+			}
+
+			if documentType == "Automation" {
+				startAutomationExecutions(svc, spec.Document, parameters, instanceIds, region)
+				return
+			}
+
+			terminal.Delta("Sending Command " + spec.Description + " to instances [" + strings.Join(regionInstanceNames[region], ", ") + "] in [" + region + "]!")
+
 			params := &ssm.SendCommandInput{
-				DocumentName: aws.String("AWS-RunShellScript"),
+				DocumentName: aws.String(spec.Document),
 				InstanceIds:  aws.StringSlice(instanceIds),
+				Parameters:   parameters,
+				Comment:      aws.String(truncateString("awsm sendCommand: "+spec.Description, 100)),
+			}
 
-				Parameters: map[string][]*string{
-					"commands": {
-						aws.String(command),
-					},
-				},
-				Comment: aws.String("awsm sendCommand: " + command),
+			if spec.OutputS3Bucket != "" {
+				params.SetOutputS3BucketName(spec.OutputS3Bucket)
+				if spec.OutputS3KeyPrefix != "" {
+					params.SetOutputS3KeyPrefix(spec.OutputS3KeyPrefix)
+				}
 			}
 
 			resp, err := svc.SendCommand(params)
 			if err != nil {
 				terminal.ErrorLine(err.Error())
+				mu.Lock()
+				errs = append(errs, region)
+				mu.Unlock()
 				return
 			} else {
-				terminal.Information("Sent Command [" + command + "] [" + aws.StringValue(resp.Command.CommandId) + "] to instances [" + strings.Join(regionInstanceNames[region], ", ") + "] in [" + region + "]!")
+				terminal.Information("Sent Command " + spec.Description + " [" + aws.StringValue(resp.Command.CommandId) + "] to instances [" + strings.Join(regionInstanceNames[region], ", ") + "] in [" + region + "]!")
 			}
 
 			targetCount := int(aws.Int64Value(resp.Command.TargetCount))
@@ -414,19 +533,153 @@ func runCommand(instList *SSMInstances, command string, dryRun bool) (*CommandIn
 					time.Sleep(time.Second * 10)
 				} else {
 					terminal.Information("Recieved a response from [" + region + "]!")
+					mu.Lock()
 					*cmdInvocationsCombined = append(*cmdInvocationsCombined, cmdInvocations...)
+					mu.Unlock()
 					break
 				}
 			}
 
-		}(region, instanceIds, command)
+		}(region, instanceIds)
 	}
 
 	wg.Wait()
 
+	if len(errs) > 0 {
+		return cmdInvocationsCombined, errors.New("Error sending " + spec.Description + " in [" + strings.Join(errs, ", ") + "]!")
+	}
+
 	return cmdInvocationsCombined, nil
 }
 
+// buildDocumentParameters looks up an SSM Document and converts the untyped parameters of a command spec into the types it declares.
+// The working directory and timeout of the spec are only passed to documents that declare them, like AWS-RunShellScript.
+func buildDocumentParameters(svc *ssm.SSM, spec commandSpec) (string, map[string][]*string, error) {
+
+	resp, err := svc.DescribeDocument(&ssm.DescribeDocumentInput{
+		Name: aws.String(spec.Document),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return "", nil, errors.New("Unable to find Document [" + spec.Document + "]: " + awsErr.Message())
+		}
+		return "", nil, err
+	}
+
+	declared := make(map[string]string)
+	for _, param := range resp.Document.Parameters {
+		declared[aws.StringValue(param.Name)] = aws.StringValue(param.Type)
+	}
+
+	values := make(map[string][]string)
+	for key, value := range spec.Parameters {
+		values[key] = value
+	}
+	if _, ok := declared["workingDirectory"]; ok && spec.WorkingDirectory != "" {
+		values["workingDirectory"] = []string{spec.WorkingDirectory}
+	}
+	if _, ok := declared["executionTimeout"]; ok && spec.TimeoutSeconds > 0 {
+		values["executionTimeout"] = []string{strconv.Itoa(spec.TimeoutSeconds)}
+	}
+
+	parameters := make(map[string][]*string)
+	for key, value := range values {
+		paramType, ok := declared[key]
+		if !ok {
+			return "", nil, errors.New("Document [" + spec.Document + "] does not have a parameter named [" + key + "]")
+		}
+
+		switch paramType {
+		case "StringList":
+			// Allow comma separated lists as well as repeated keys, except for scripts
+			if key != "commands" && len(value) == 1 {
+				value = strings.Split(value[0], ",")
+			}
+
+		case "Integer":
+			for _, v := range value {
+				if _, err := strconv.Atoi(v); err != nil {
+					return "", nil, errors.New("Parameter [" + key + "] of Document [" + spec.Document + "] must be an Integer")
+				}
+			}
+
+		case "Boolean":
+			for _, v := range value {
+				if _, err := strconv.ParseBool(v); err != nil {
+					return "", nil, errors.New("Parameter [" + key + "] of Document [" + spec.Document + "] must be a Boolean")
+				}
+			}
+
+		default:
+			if len(value) > 1 {
+				return "", nil, errors.New("Parameter [" + key + "] of Document [" + spec.Document + "] only accepts a single value")
+			}
+		}
+
+		parameters[key] = aws.StringSlice(value)
+	}
+
+	return aws.StringValue(resp.Document.DocumentType), parameters, nil
+}
+
+// startAutomationExecutions starts an Automation Document, once per instance when it takes an InstanceId parameter
+func startAutomationExecutions(svc *ssm.SSM, document string, parameters map[string][]*string, instanceIds []string, region string) {
+
+	resp, err := svc.DescribeDocument(&ssm.DescribeDocumentInput{
+		Name: aws.String(document),
+	})
+	if err != nil {
+		terminal.ErrorLine(err.Error())
+		return
+	}
+
+	perInstance := false
+	for _, param := range resp.Document.Parameters {
+		if aws.StringValue(param.Name) == "InstanceId" && len(parameters["InstanceId"]) == 0 {
+			perInstance = true
+		}
+	}
+
+	targets := []string{""}
+	if perInstance {
+		targets = instanceIds
+	}
+
+	for _, instanceId := range targets {
+		params := make(map[string][]*string)
+		for key, value := range parameters {
+			params[key] = value
+		}
+		if instanceId != "" {
+			params["InstanceId"] = []*string{aws.String(instanceId)}
+		}
+
+		execution, err := svc.StartAutomationExecution(&ssm.StartAutomationExecutionInput{
+			DocumentName: aws.String(document),
+			Parameters:   params,
+		})
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok {
+				terminal.ErrorLine(awsErr.Message())
+			} else {
+				terminal.ErrorLine(err.Error())
+			}
+			continue
+		}
+
+		terminal.Delta("Started Automation [" + document + "] execution [" + aws.StringValue(execution.AutomationExecutionId) + "] in [" + region + "]!")
+	}
+}
+
+// truncateString shortens a string to a maximum length
+func truncateString(str string, max int) string {
+	runes := []rune(str)
+	if len(runes) > max {
+		return string(runes[:max])
+	}
+	return str
+}
+
 //
 func (i *CommandInvocations) PrintOutput() {
 	if len(*i) == 0 {
@@ -210,7 +210,7 @@ func GetSubnetsByVpcID(vpcID string, region string) (Subnets, error) {
 		subList[i].Marshal(subnet, region, vpcList)
 	}
 
-	return Subnets{}, nil
+	return subList, nil
 }
 
 // GetSubnetName returns the name of a Subnet given its ID
@@ -346,6 +346,34 @@ func CreateSubnet(class, name, vpcSearch, ip, az string, dryRun bool) error {
 		namePrefix = vpc.VpcID
 	}
 
+	if cfg.NetworkAcl != "" {
+		// Use an existing Network ACL of this class in the VPC, or create a new one
+		networkAcls, err := vpc.GetVpcNetworkAclsByTag("Class", cfg.NetworkAcl)
+		if err != nil {
+			return err
+		}
+
+		var networkAclId string
+		if len(networkAcls) > 0 {
+			networkAclId = networkAcls[0].NetworkAclID
+			terminal.Information("Found Network ACL [" + networkAclId + "] with a class of [" + cfg.NetworkAcl + "]!")
+		} else {
+			terminal.Notice("Creating a new Network ACL...")
+
+			networkAclId, err = createNetworkAcl(cfg.NetworkAcl, namePrefix+"-"+cfg.NetworkAcl+"-network-acl", vpc, dryRun)
+			if err != nil {
+				return err
+			}
+		}
+
+		terminal.Notice("Associating Network ACL to Subnet...")
+
+		err = associateNetworkAcl(networkAclId, subnetId, region, dryRun)
+		if err != nil {
+			return err
+		}
+	}
+
 	if cfg.CreateInternetGateway {
 		terminal.Notice("Creating a new Internet Gateway...")
 
@@ -447,6 +475,12 @@ func CreateSubnet(class, name, vpcSearch, ip, az string, dryRun bool) error {
 		}
 	}
 
+	// Add to any interface endpoints in the VPC
+	err = addSubnetToVpcEndpoints(subnetId, subnetAz, vpc.VpcID, region, dryRun)
+	if err != nil {
+		return err
+	}
+
 	terminal.Information("Done!")
 
 	return nil
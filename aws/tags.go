@@ -2,6 +2,7 @@ package aws
 
 import (
 	"errors"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -69,3 +70,71 @@ func SetEc2NameAndClassTags(resource *string, name, class, region string) error
 	}
 	return nil
 }
+
+// setEc2ClassTag sets only the Class tag of an EC2 asset, leaving its Name alone
+func setEc2ClassTag(resource, class, region string) error {
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := ec2.New(sess)
+
+	_, err := svc.CreateTags(&ec2.CreateTagsInput{
+		Resources: []*string{
+			aws.String(resource),
+		},
+		Tags: []*ec2.Tag{
+			{
+				Key:   aws.String("Class"),
+				Value: aws.String(class),
+			},
+		},
+	})
+
+	return err
+}
+
+// copyEc2Tags copies the tags of one EC2 asset onto another in the same region, skipping the reserved aws: tags
+func copyEc2Tags(sourceID, destinationID, region string) error {
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := ec2.New(sess)
+
+	resp, err := svc.DescribeTags(&ec2.DescribeTagsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("resource-id"),
+				Values: []*string{aws.String(sourceID)},
+			},
+		},
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return errors.New(awsErr.Message())
+		}
+		return err
+	}
+
+	var tags []*ec2.Tag
+	for _, tag := range resp.Tags {
+		if strings.HasPrefix(aws.StringValue(tag.Key), "aws:") {
+			continue
+		}
+		tags = append(tags, &ec2.Tag{Key: tag.Key, Value: tag.Value})
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	_, err = svc.CreateTags(&ec2.CreateTagsInput{
+		Resources: []*string{aws.String(destinationID)},
+		Tags:      tags,
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return errors.New(awsErr.Message())
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,785 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/murdinc/awsm/aws/regions"
+	"github.com/murdinc/awsm/models"
+	"github.com/murdinc/terminal"
+	"github.com/olekukonko/tablewriter"
+)
+
+// TeardownSteps represents the reverse dependency ordered list of assets to delete when tearing down VPCs
+type TeardownSteps []TeardownStep
+
+// TeardownStep represents a single asset to delete when tearing down a VPC
+type TeardownStep models.TeardownStep
+
+// teardownAttempts is how many times a step is tried while the assets that depended on it are still going away
+const teardownAttempts = 10
+
+// teardownWaitTimeout is how long a step waits for an asset that is being deleted or detached to be gone
+const teardownWaitTimeout = 15 * time.Minute
+
+// teardownRetryCodes are the error codes AWS returns while an asset is still in use by assets that were just deleted
+var teardownRetryCodes = map[string]bool{
+	"DependencyViolation":           true,
+	"ResourceInUse":                 true,
+	"InvalidNetworkInterface.InUse": true,
+	"InvalidGroup.InUse":            true,
+	"ScalingActivityInProgress":     true,
+	"RequestLimitExceeded":          true,
+	"Throttling":                    true,
+}
+
+// Teardown deletes one or more VPCs that match the provided search term or class, along with every asset inside of them,
+// in reverse dependency order
+func Teardown(vpcSearch, class, region string, dryRun bool) error {
+
+	// --dry-run flag
+	if dryRun {
+		terminal.Information("--dry-run flag is set, not making any actual changes!")
+	}
+
+	vpcs, err := teardownVpcs(vpcSearch, class, region)
+	if err != nil {
+		return err
+	}
+
+	steps := TeardownSteps{}
+	var vpcIDs []string
+	for _, vpc := range vpcs {
+		terminal.Notice("Discovering the assets in VPC [" + vpc.VpcID + "] in [" + vpc.Region + "]...")
+
+		vpcSteps, err := vpcTeardownSteps(vpc)
+		if err != nil {
+			return err
+		}
+
+		steps = append(steps, vpcSteps...)
+		vpcIDs = append(vpcIDs, vpc.VpcID)
+	}
+
+	for i := range steps {
+		steps[i].Step = i + 1
+	}
+
+	steps.PrintTable()
+
+	if dryRun {
+		return nil
+	}
+
+	// Typed confirmation
+	confirmation := strings.Join(vpcIDs, " ")
+	terminal.ShowErrorMessage("Warning", fmt.Sprintf("This will permanently delete the %d assets above!", len(steps)))
+	if strings.TrimSpace(terminal.PromptString("Type ["+confirmation+"] to confirm:")) != confirmation {
+		return errors.New("Confirmation did not match, Aborting!")
+	}
+
+	for _, step := range steps {
+		terminal.Delta(fmt.Sprintf("Step %d - %s %s [%s] in [%s]", step.Step, step.Action, step.AssetType, step.ID, step.Region))
+
+		err := retryTeardownStep(step)
+		if err != nil {
+			return fmt.Errorf("Teardown stopped at step %d, %s [%s]: %s", step.Step, step.AssetType, step.ID, err)
+		}
+	}
+
+	terminal.Information("Done!")
+
+	return nil
+}
+
+// teardownVpcs returns the VPCs that match a search term or exactly match a class, refusing default VPCs
+func teardownVpcs(vpcSearch, class, region string) (Vpcs, error) {
+
+	if (vpcSearch == "") == (class == "") {
+		return Vpcs{}, errors.New("Please provide either a VPC search term or a VPC class!")
+	}
+
+	search := vpcSearch
+	if class != "" {
+		search = class
+	}
+
+	vpcList := new(Vpcs)
+	var err error
+
+	// Check if we were given a region or not
+	if region != "" {
+		if !regions.ValidRegion(region) {
+			return Vpcs{}, errors.New("Region [" + region + "] is Invalid!")
+		}
+		err = GetRegionVpcs(region, vpcList, search)
+	} else {
+		var errs []error
+		vpcList, errs = GetVpcs(search)
+		if len(errs) > 0 {
+			err = errs[0]
+		}
+	}
+
+	if err != nil {
+		return Vpcs{}, errors.New("Error gathering VPC list, refusing to tear down a partial result: " + err.Error())
+	}
+
+	vpcs := Vpcs{}
+	for _, vpc := range *vpcList {
+		if class != "" && vpc.Class != class {
+			continue
+		}
+		if vpc.Default {
+			return Vpcs{}, errors.New("VPC [" + vpc.VpcID + "] in [" + vpc.Region + "] is a default VPC, refusing to tear it down!")
+		}
+		vpcs = append(vpcs, vpc)
+	}
+
+	if len(vpcs) == 0 {
+		return vpcs, errors.New("No VPCs found for your search terms.")
+	}
+
+	vpcs.PrintTable()
+
+	return vpcs, nil
+}
+
+// vpcTeardownSteps discovers every asset in a VPC and returns them in the order they need to be deleted
+func vpcTeardownSteps(vpc Vpc) (steps TeardownSteps, err error) {
+
+	region := vpc.Region
+	add := func(assetType, id, name, action string) {
+		steps = append(steps, TeardownStep{
+			AssetType: assetType,
+			ID:        id,
+			Name:      name,
+			Action:    action,
+			VpcID:     vpc.VpcID,
+			Region:    region,
+		})
+	}
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := ec2.New(sess)
+
+	vpcFilter := []*ec2.Filter{
+		{
+			Name:   aws.String("vpc-id"),
+			Values: []*string{aws.String(vpc.VpcID)},
+		},
+	}
+
+	subnets, err := GetSubnetsByVpcID(vpc.VpcID, region)
+	if err != nil {
+		return steps, err
+	}
+
+	subnetIDs := make(map[string]bool, len(subnets))
+	for _, subnet := range subnets {
+		subnetIDs[subnet.SubnetID] = true
+	}
+
+	// AutoScaling Groups launching into the subnets of the VPC, force deleted along with their instances
+	asgList := new(AutoScaleGroups)
+	err = GetRegionAutoScaleGroups(region, asgList, "")
+	if err != nil {
+		return steps, err
+	}
+	for _, asg := range *asgList {
+		for _, subnetID := range strings.Split(asg.SubnetID, ",") {
+			if subnetIDs[strings.TrimSpace(subnetID)] {
+				add("autoscalegroup", asg.Name, asg.Class, "Force Delete")
+				break
+			}
+		}
+	}
+
+	// Load Balancers
+	lbList := new(LoadBalancers)
+	err = GetRegionLoadBalancers(region, lbList, "")
+	if err != nil {
+		return steps, err
+	}
+	for _, lb := range *lbList {
+		if lb.VpcID == vpc.VpcID {
+			add("loadbalancer", lb.Name, lb.Name, "Delete")
+		}
+	}
+
+	lbV2List := new(LoadBalancersV2)
+	err = GetRegionLoadBalancersV2(region, lbV2List)
+	if err != nil {
+		return steps, err
+	}
+	for _, lb := range *lbV2List {
+		if lb.VpcID == vpc.VpcID {
+			add("loadbalancerv2", lb.LoadBalancerArn, lb.Name, "Delete")
+		}
+	}
+
+	// Instances
+	instResp, err := svc.DescribeInstances(&ec2.DescribeInstancesInput{
+		Filters: append(vpcFilter, &ec2.Filter{
+			Name:   aws.String("instance-state-name"),
+			Values: aws.StringSlice([]string{"pending", "running", "shutting-down", "stopping", "stopped"}),
+		}),
+	})
+	if err != nil {
+		return steps, teardownError(err)
+	}
+	for _, reservation := range instResp.Reservations {
+		for _, instance := range reservation.Instances {
+			add("instance", aws.StringValue(instance.InstanceId), GetTagValue("Name", instance.Tags), "Terminate")
+		}
+	}
+
+	// NAT Gateways
+	natResp, err := svc.DescribeNatGateways(&ec2.DescribeNatGatewaysInput{
+		Filter: append(vpcFilter, &ec2.Filter{
+			Name:   aws.String("state"),
+			Values: aws.StringSlice([]string{"pending", "available"}),
+		}),
+	})
+	if err != nil {
+		return steps, teardownError(err)
+	}
+	for _, gateway := range natResp.NatGateways {
+		add("natgateway", aws.StringValue(gateway.NatGatewayId), GetTagValue("Name", gateway.Tags), "Delete")
+	}
+
+	// VPC Endpoints
+	endpoints, err := getVpcEndpointsByVpcID(vpc.VpcID, region)
+	if err != nil {
+		return steps, teardownError(err)
+	}
+	for _, endpoint := range endpoints {
+		if state := strings.ToLower(endpoint.State); state != "deleted" && state != "deleting" {
+			add("vpcendpoint", endpoint.VpcEndpointID, endpoint.ServiceName, "Delete")
+		}
+	}
+
+	// Network Interfaces that will not go away along with their instances
+	eniResp, err := svc.DescribeNetworkInterfaces(&ec2.DescribeNetworkInterfacesInput{
+		Filters: vpcFilter,
+	})
+	if err != nil {
+		return steps, teardownError(err)
+	}
+	for _, eni := range eniResp.NetworkInterfaces {
+		if eni.Attachment != nil && aws.StringValue(eni.Attachment.InstanceId) != "" && aws.BoolValue(eni.Attachment.DeleteOnTermination) {
+			continue
+		}
+		add("networkinterface", aws.StringValue(eni.NetworkInterfaceId), aws.StringValue(eni.Description), "Delete")
+	}
+
+	// Security Groups, revoking the grants between groups first so that they can be deleted in any order
+	sgResp, err := svc.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+		Filters: vpcFilter,
+	})
+	if err != nil {
+		return steps, teardownError(err)
+	}
+	var secGrps []*ec2.SecurityGroup
+	for _, secGrp := range sgResp.SecurityGroups {
+		if aws.StringValue(secGrp.GroupName) == "default" {
+			continue
+		}
+		secGrps = append(secGrps, secGrp)
+
+		if len(groupPermissions(secGrp.IpPermissions)) > 0 || len(groupPermissions(secGrp.IpPermissionsEgress)) > 0 {
+			add("securitygroup", aws.StringValue(secGrp.GroupId), aws.StringValue(secGrp.GroupName), "Revoke Group Grants")
+		}
+	}
+	for _, secGrp := range secGrps {
+		add("securitygroup", aws.StringValue(secGrp.GroupId), aws.StringValue(secGrp.GroupName), "Delete")
+	}
+
+	// Subnets
+	for _, subnet := range subnets {
+		add("subnet", subnet.SubnetID, subnet.Name, "Delete")
+	}
+
+	// Network ACLs
+	aclResp, err := svc.DescribeNetworkAcls(&ec2.DescribeNetworkAclsInput{
+		Filters: vpcFilter,
+	})
+	if err != nil {
+		return steps, teardownError(err)
+	}
+	for _, acl := range aclResp.NetworkAcls {
+		if !aws.BoolValue(acl.IsDefault) {
+			add("networkacl", aws.StringValue(acl.NetworkAclId), GetTagValue("Name", acl.Tags), "Delete")
+		}
+	}
+
+	// Route Tables, the main route table goes away with the VPC
+	rtResp, err := svc.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+		Filters: vpcFilter,
+	})
+	if err != nil {
+		return steps, teardownError(err)
+	}
+	for _, rt := range rtResp.RouteTables {
+		isMain := false
+		for _, association := range rt.Associations {
+			if aws.BoolValue(association.Main) {
+				isMain = true
+			}
+		}
+		if !isMain {
+			add("routetable", aws.StringValue(rt.RouteTableId), GetTagValue("Name", rt.Tags), "Delete")
+		}
+	}
+
+	// VPN Gateway attachments, the gateways themselves may be used elsewhere and are only detached
+	vgwResp, err := svc.DescribeVpnGateways(&ec2.DescribeVpnGatewaysInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("attachment.vpc-id"),
+				Values: []*string{aws.String(vpc.VpcID)},
+			},
+		},
+	})
+	if err != nil {
+		return steps, teardownError(err)
+	}
+	for _, vgw := range vgwResp.VpnGateways {
+		for _, attachment := range vgw.VpcAttachments {
+			if aws.StringValue(attachment.VpcId) == vpc.VpcID && aws.StringValue(attachment.State) != "detached" {
+				add("vpngateway", aws.StringValue(vgw.VpnGatewayId), GetTagValue("Name", vgw.Tags), "Detach")
+			}
+		}
+	}
+
+	// Transit Gateway VPC attachments
+	tgwResp, err := svc.DescribeTransitGatewayVpcAttachments(&ec2.DescribeTransitGatewayVpcAttachmentsInput{
+		Filters: append(vpcFilter, &ec2.Filter{
+			Name:   aws.String("state"),
+			Values: aws.StringSlice([]string{"initiating", "initiatingRequest", "pendingAcceptance", "pending", "available", "modifying", "rollingBack"}),
+		}),
+	})
+	if err != nil {
+		return steps, teardownError(err)
+	}
+	for _, attachment := range tgwResp.TransitGatewayVpcAttachments {
+		add("transitgatewayattachment", aws.StringValue(attachment.TransitGatewayAttachmentId), aws.StringValue(attachment.TransitGatewayId), "Delete")
+	}
+
+	// VPC Peering Connections, from either side
+	seenPeering := make(map[string]bool)
+	for _, side := range []string{"requester-vpc-info.vpc-id", "accepter-vpc-info.vpc-id"} {
+		peerResp, err := svc.DescribeVpcPeeringConnections(&ec2.DescribeVpcPeeringConnectionsInput{
+			Filters: []*ec2.Filter{
+				{
+					Name:   aws.String(side),
+					Values: []*string{aws.String(vpc.VpcID)},
+				},
+				{
+					Name:   aws.String("status-code"),
+					Values: aws.StringSlice([]string{"initiating-request", "pending-acceptance", "provisioning", "active"}),
+				},
+			},
+		})
+		if err != nil {
+			return steps, teardownError(err)
+		}
+		for _, peering := range peerResp.VpcPeeringConnections {
+			id := aws.StringValue(peering.VpcPeeringConnectionId)
+			if !seenPeering[id] {
+				seenPeering[id] = true
+				add("vpcpeeringconnection", id, GetTagValue("Name", peering.Tags), "Delete")
+			}
+		}
+	}
+
+	// Egress Only Internet Gateways
+	eigwResp, err := svc.DescribeEgressOnlyInternetGateways(&ec2.DescribeEgressOnlyInternetGatewaysInput{})
+	if err != nil {
+		return steps, teardownError(err)
+	}
+	for _, eigw := range eigwResp.EgressOnlyInternetGateways {
+		for _, attachment := range eigw.Attachments {
+			if aws.StringValue(attachment.VpcId) == vpc.VpcID {
+				add("egressonlyinternetgateway", aws.StringValue(eigw.EgressOnlyInternetGatewayId), GetTagValue("Name", eigw.Tags), "Delete")
+				break
+			}
+		}
+	}
+
+	// Internet Gateways
+	igResp, err := svc.DescribeInternetGateways(&ec2.DescribeInternetGatewaysInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("attachment.vpc-id"),
+				Values: []*string{aws.String(vpc.VpcID)},
+			},
+		},
+	})
+	if err != nil {
+		return steps, teardownError(err)
+	}
+	for _, ig := range igResp.InternetGateways {
+		add("internetgateway", aws.StringValue(ig.InternetGatewayId), GetTagValue("Name", ig.Tags), "Detach and Delete")
+	}
+
+	// The VPC itself
+	add("vpc", vpc.VpcID, vpc.Name, "Delete")
+
+	return steps, nil
+}
+
+// groupPermissions returns the permissions that grant access to other Security Groups
+func groupPermissions(permissions []*ec2.IpPermission) (grants []*ec2.IpPermission) {
+	for _, permission := range permissions {
+		if len(permission.UserIdGroupPairs) > 0 {
+			grants = append(grants, permission)
+		}
+	}
+	return grants
+}
+
+// retryTeardownStep runs a teardown step, retrying while AWS reports the asset as still in use and treating assets that are
+// already gone as deleted
+func retryTeardownStep(step TeardownStep) error {
+
+	delay := 5 * time.Second
+
+	for attempt := 1; ; attempt++ {
+		err := step.run()
+		if err == nil {
+			return nil
+		}
+
+		awsErr, ok := err.(awserr.Error)
+		if !ok {
+			return err
+		}
+
+		if strings.HasSuffix(awsErr.Code(), "NotFound") {
+			terminal.Notice("The " + step.AssetType + " [" + step.ID + "] is already gone.")
+			return nil
+		}
+
+		if !teardownRetryCodes[awsErr.Code()] || attempt == teardownAttempts {
+			return errors.New(awsErr.Message())
+		}
+
+		terminal.Notice(fmt.Sprintf("The %s [%s] is still in use [%s], retrying in %s...", step.AssetType, step.ID, awsErr.Code(), delay))
+		time.Sleep(delay)
+
+		if delay < 30*time.Second {
+			delay *= 2
+		}
+	}
+}
+
+// waitForTeardownStep polls until an asset of a teardown step is gone, giving up after teardownWaitTimeout
+func waitForTeardownStep(step TeardownStep, done func() (bool, error)) error {
+
+	deadline := time.Now().Add(teardownWaitTimeout)
+
+	for {
+		gone, err := done()
+		if err != nil || gone {
+			return err
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("The %s [%s] was still not gone after %s", step.AssetType, step.ID, teardownWaitTimeout)
+		}
+
+		time.Sleep(10 * time.Second)
+	}
+}
+
+// run makes the AWS calls for a single teardown step, returning the raw AWS errors so that they can be retried
+func (s TeardownStep) run() error {
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(s.Region)}))
+	svc := ec2.New(sess)
+
+	switch s.AssetType {
+
+	case "autoscalegroup":
+		asgSvc := autoscaling.New(sess)
+		_, err := asgSvc.DeleteAutoScalingGroup(&autoscaling.DeleteAutoScalingGroupInput{
+			AutoScalingGroupName: aws.String(s.ID),
+			ForceDelete:          aws.Bool(true),
+		})
+		if err != nil {
+			return err
+		}
+
+		terminal.Notice("Waiting for AutoScaling Group [" + s.ID + "] to terminate its instances...")
+		return asgSvc.WaitUntilGroupNotExists(&autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: []*string{aws.String(s.ID)},
+		})
+
+	case "loadbalancer":
+		_, err := elb.New(sess).DeleteLoadBalancer(&elb.DeleteLoadBalancerInput{
+			LoadBalancerName: aws.String(s.ID),
+		})
+		return err
+
+	case "loadbalancerv2":
+		lbSvc := elbv2.New(sess)
+		_, err := lbSvc.DeleteLoadBalancer(&elbv2.DeleteLoadBalancerInput{
+			LoadBalancerArn: aws.String(s.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		return lbSvc.WaitUntilLoadBalancersDeleted(&elbv2.DescribeLoadBalancersInput{
+			LoadBalancerArns: []*string{aws.String(s.ID)},
+		})
+
+	case "instance":
+		_, err := svc.TerminateInstances(&ec2.TerminateInstancesInput{
+			InstanceIds: []*string{aws.String(s.ID)},
+		})
+		if err != nil {
+			return err
+		}
+
+		terminal.Notice("Waiting for Instance [" + s.ID + "] to terminate...")
+		return svc.WaitUntilInstanceTerminated(&ec2.DescribeInstancesInput{
+			InstanceIds: []*string{aws.String(s.ID)},
+		})
+
+	case "natgateway":
+		_, err := svc.DeleteNatGateway(&ec2.DeleteNatGatewayInput{
+			NatGatewayId: aws.String(s.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		terminal.Notice("Waiting for NAT Gateway [" + s.ID + "] to be deleted...")
+		return waitForTeardownStep(s, func() (bool, error) {
+			resp, err := svc.DescribeNatGateways(&ec2.DescribeNatGatewaysInput{
+				NatGatewayIds: []*string{aws.String(s.ID)},
+			})
+			if err != nil {
+				return false, err
+			}
+			if len(resp.NatGateways) == 0 {
+				return true, nil
+			}
+			switch aws.StringValue(resp.NatGateways[0].State) {
+			case "deleted":
+				return true, nil
+			case "failed":
+				return false, errors.New("NAT Gateway [" + s.ID + "] failed: " + aws.StringValue(resp.NatGateways[0].FailureMessage))
+			}
+			return false, nil
+		})
+
+	case "vpcendpoint":
+		resp, err := svc.DeleteVpcEndpoints(&ec2.DeleteVpcEndpointsInput{
+			VpcEndpointIds: []*string{aws.String(s.ID)},
+		})
+		if err != nil {
+			return err
+		}
+		if len(resp.Unsuccessful) > 0 && resp.Unsuccessful[0].Error != nil {
+			return awserr.New(aws.StringValue(resp.Unsuccessful[0].Error.Code), aws.StringValue(resp.Unsuccessful[0].Error.Message), nil)
+		}
+		return nil
+
+	case "networkinterface":
+		_, err := svc.DeleteNetworkInterface(&ec2.DeleteNetworkInterfaceInput{
+			NetworkInterfaceId: aws.String(s.ID),
+		})
+		return err
+
+	case "securitygroup":
+		if s.Action == "Delete" {
+			_, err := svc.DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{
+				GroupId: aws.String(s.ID),
+			})
+			return err
+		}
+
+		// Revoke the grants to other groups as they are now, they might have changed since the plan was shown
+		resp, err := svc.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+			GroupIds: []*string{aws.String(s.ID)},
+		})
+		if err != nil {
+			return err
+		}
+		if len(resp.SecurityGroups) == 0 {
+			return nil
+		}
+
+		if ingress := groupPermissions(resp.SecurityGroups[0].IpPermissions); len(ingress) > 0 {
+			_, err = svc.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
+				GroupId:       aws.String(s.ID),
+				IpPermissions: ingress,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if egress := groupPermissions(resp.SecurityGroups[0].IpPermissionsEgress); len(egress) > 0 {
+			_, err = svc.RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{
+				GroupId:       aws.String(s.ID),
+				IpPermissions: egress,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case "subnet":
+		_, err := svc.DeleteSubnet(&ec2.DeleteSubnetInput{
+			SubnetId: aws.String(s.ID),
+		})
+		return err
+
+	case "networkacl":
+		_, err := svc.DeleteNetworkAcl(&ec2.DeleteNetworkAclInput{
+			NetworkAclId: aws.String(s.ID),
+		})
+		return err
+
+	case "routetable":
+		_, err := svc.DeleteRouteTable(&ec2.DeleteRouteTableInput{
+			RouteTableId: aws.String(s.ID),
+		})
+		return err
+
+	case "vpngateway":
+		_, err := svc.DetachVpnGateway(&ec2.DetachVpnGatewayInput{
+			VpnGatewayId: aws.String(s.ID),
+			VpcId:        aws.String(s.VpcID),
+		})
+		if err != nil {
+			return err
+		}
+
+		terminal.Notice("Waiting for VPN Gateway [" + s.ID + "] to detach...")
+		return waitForTeardownStep(s, func() (bool, error) {
+			resp, err := svc.DescribeVpnGateways(&ec2.DescribeVpnGatewaysInput{
+				VpnGatewayIds: []*string{aws.String(s.ID)},
+			})
+			if err != nil {
+				return false, err
+			}
+			for _, vgw := range resp.VpnGateways {
+				for _, attachment := range vgw.VpcAttachments {
+					if aws.StringValue(attachment.VpcId) == s.VpcID && aws.StringValue(attachment.State) != "detached" {
+						return false, nil
+					}
+				}
+			}
+			return true, nil
+		})
+
+	case "transitgatewayattachment":
+		_, err := svc.DeleteTransitGatewayVpcAttachment(&ec2.DeleteTransitGatewayVpcAttachmentInput{
+			TransitGatewayAttachmentId: aws.String(s.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		terminal.Notice("Waiting for Transit Gateway attachment [" + s.ID + "] to be deleted...")
+		return waitForTeardownStep(s, func() (bool, error) {
+			resp, err := svc.DescribeTransitGatewayVpcAttachments(&ec2.DescribeTransitGatewayVpcAttachmentsInput{
+				TransitGatewayAttachmentIds: []*string{aws.String(s.ID)},
+			})
+			if err != nil {
+				return false, err
+			}
+			if len(resp.TransitGatewayVpcAttachments) == 0 {
+				return true, nil
+			}
+			switch aws.StringValue(resp.TransitGatewayVpcAttachments[0].State) {
+			case "deleted":
+				return true, nil
+			case "failed", "failing":
+				return false, errors.New("Transit Gateway attachment [" + s.ID + "] failed to delete!")
+			}
+			return false, nil
+		})
+
+	case "vpcpeeringconnection":
+		_, err := svc.DeleteVpcPeeringConnection(&ec2.DeleteVpcPeeringConnectionInput{
+			VpcPeeringConnectionId: aws.String(s.ID),
+		})
+		return err
+
+	case "egressonlyinternetgateway":
+		_, err := svc.DeleteEgressOnlyInternetGateway(&ec2.DeleteEgressOnlyInternetGatewayInput{
+			EgressOnlyInternetGatewayId: aws.String(s.ID),
+		})
+		return err
+
+	case "internetgateway":
+		_, err := svc.DetachInternetGateway(&ec2.DetachInternetGatewayInput{
+			InternetGatewayId: aws.String(s.ID),
+			VpcId:             aws.String(s.VpcID),
+		})
+		if err != nil {
+			// A retry after the detach already went through
+			if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != "Gateway.NotAttached" {
+				return err
+			}
+		}
+
+		_, err = svc.DeleteInternetGateway(&ec2.DeleteInternetGatewayInput{
+			InternetGatewayId: aws.String(s.ID),
+		})
+		return err
+
+	case "vpc":
+		_, err := svc.DeleteVpc(&ec2.DeleteVpcInput{
+			VpcId: aws.String(s.ID),
+		})
+		return err
+	}
+
+	return errors.New("Unknown asset type [" + s.AssetType + "]!")
+}
+
+// teardownError converts an AWS error into a plain error with just its message
+func teardownError(err error) error {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return errors.New(awsErr.Message())
+	}
+	return err
+}
+
+// PrintTable Prints an ascii table of the list of Teardown Steps
+func (t *TeardownSteps) PrintTable() {
+	if len(*t) == 0 {
+		terminal.ShowErrorMessage("Warning", "No Teardown Steps Found!")
+		return
+	}
+
+	var header []string
+	rows := make([][]string, len(*t))
+
+	for index, step := range *t {
+		models.ExtractAwsmTable(index, step, &header, &rows)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(header)
+	table.AppendBulk(rows)
+	table.Render()
+}
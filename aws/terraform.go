@@ -0,0 +1,802 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/murdinc/awsm/config"
+)
+
+// terraformAssetTypes is the order asset types are written in, dependencies first
+var terraformAssetTypes = []string{"vpcs", "subnets", "securitygroups", "loadbalancers", "launchconfigurations", "autoscalegroups", "instances", "volumes"}
+
+// validTerraformAssetType returns true if an asset type can be exported to Terraform
+func validTerraformAssetType(assetType string) bool {
+	for _, t := range terraformAssetTypes {
+		if t == assetType {
+			return true
+		}
+	}
+	return false
+}
+
+// tfExpr is a raw Terraform expression, like a reference to another resource, that is written without quotes
+type tfExpr string
+
+// tfAttr is a single attribute of a Terraform block
+type tfAttr struct {
+	name  string
+	value interface{}
+}
+
+// tfBlock is a Terraform block, like a resource, an import or a nested ingress block
+type tfBlock struct {
+	kind   string
+	labels []string
+	attrs  []tfAttr
+	blocks []tfBlock
+}
+
+// tfExport holds the state of a Terraform export while the assets are being gathered and written
+type tfExport struct {
+	blocks  []tfBlock
+	imports []tfBlock
+	regions map[string]bool
+	names   map[string]bool   // resource type.name -> taken
+	refs    map[string]tfExpr // aws id or name -> reference to the exported resource
+
+	vpcs                 Vpcs
+	subnets              Subnets
+	securityGroups       SecurityGroups
+	loadBalancers        LoadBalancers
+	launchConfigurations LaunchConfigs
+	autoScaleGroups      AutoScaleGroups
+	instances            Instances
+	volumes              Volumes
+
+	secGrpIDs map[string]map[string]string // region -> security group name -> id, for grants that reference groups outside of the export
+}
+
+// ExportTerraform builds Terraform resource blocks and matching import blocks for the assets of one or more comma separated asset types that
+// match the search term. References between assets in the same export, like the Security Groups of an Instance, are written as references
+// to the exported resources instead of literal ids.
+func ExportTerraform(assetTypes, search string) ([]byte, error) {
+
+	export := &tfExport{
+		regions:   make(map[string]bool),
+		names:     make(map[string]bool),
+		refs:      make(map[string]tfExpr),
+		secGrpIDs: make(map[string]map[string]string),
+	}
+
+	requested := make(map[string]bool)
+	for _, assetType := range strings.Split(assetTypes, ",") {
+		assetType = strings.ToLower(strings.TrimSpace(assetType))
+		if !validTerraformAssetType(assetType) {
+			return nil, errors.New("Asset type [" + assetType + "] is invalid! Must be one of: " + strings.Join(terraformAssetTypes, ", "))
+		}
+		requested[assetType] = true
+	}
+
+	// Gather everything first, so that references can be resolved regardless of the order the asset types were requested in
+	for _, assetType := range terraformAssetTypes {
+		if !requested[assetType] {
+			continue
+		}
+		err := export.gather(assetType, search)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, assetType := range terraformAssetTypes {
+		if requested[assetType] {
+			export.add(assetType)
+		}
+	}
+
+	if len(export.blocks) == 0 {
+		return nil, errors.New("No assets found matching [" + search + "]!")
+	}
+
+	var buf bytes.Buffer
+
+	regionNames := make([]string, 0, len(export.regions))
+	for region := range export.regions {
+		regionNames = append(regionNames, region)
+	}
+	sort.Strings(regionNames)
+
+	for _, region := range regionNames {
+		tfBlock{
+			kind:   "provider",
+			labels: []string{"aws"},
+			attrs: []tfAttr{
+				{"alias", tfProviderAlias(region)},
+				{"region", region},
+			},
+		}.write(&buf, 0)
+		buf.WriteString("\n")
+	}
+
+	for _, block := range append(export.blocks, export.imports...) {
+		block.write(&buf, 0)
+		buf.WriteString("\n")
+	}
+
+	return append(bytes.TrimRight(buf.Bytes(), "\n"), '\n'), nil
+}
+
+// gather looks up the assets of a single type and registers the resource names they will be exported under
+func (e *tfExport) gather(assetType, search string) error {
+	var errs []error
+
+	switch assetType {
+	case "vpcs":
+		var list *Vpcs
+		list, errs = GetVpcs(search)
+		e.vpcs = *list
+		sort.Slice(e.vpcs, func(i, j int) bool { return e.vpcs[i].Region+e.vpcs[i].VpcID < e.vpcs[j].Region+e.vpcs[j].VpcID })
+		for _, v := range e.vpcs {
+			e.refs[v.VpcID] = e.register("aws_vpc", v.Name, v.VpcID) + ".id"
+		}
+
+	case "subnets":
+		var list *Subnets
+		list, errs = GetSubnets(search)
+		e.subnets = *list
+		sort.Slice(e.subnets, func(i, j int) bool {
+			return e.subnets[i].Region+e.subnets[i].SubnetID < e.subnets[j].Region+e.subnets[j].SubnetID
+		})
+		for _, s := range e.subnets {
+			e.refs[s.SubnetID] = e.register("aws_subnet", s.Name, s.SubnetID) + ".id"
+		}
+
+	case "securitygroups":
+		var list *SecurityGroups
+		list, errs = GetSecurityGroups(search)
+		e.securityGroups = *list
+		sort.Slice(e.securityGroups, func(i, j int) bool {
+			return e.securityGroups[i].Region+e.securityGroups[i].GroupID < e.securityGroups[j].Region+e.securityGroups[j].GroupID
+		})
+		for _, s := range e.securityGroups {
+			e.refs[s.GroupID] = e.register("aws_security_group", s.Name, s.GroupID) + ".id"
+		}
+
+	case "loadbalancers":
+		var list *LoadBalancers
+		list, errs = GetLoadBalancers(search)
+		e.loadBalancers = *list
+		sort.Slice(e.loadBalancers, func(i, j int) bool {
+			return e.loadBalancers[i].Region+e.loadBalancers[i].Name < e.loadBalancers[j].Region+e.loadBalancers[j].Name
+		})
+		for _, l := range e.loadBalancers {
+			e.refs[l.Region+"/elb/"+l.Name] = e.register("aws_elb", l.Name, l.Region+"/"+l.Name) + ".name"
+		}
+
+	case "launchconfigurations":
+		var list *LaunchConfigs
+		list, errs = GetLaunchConfigurations(search)
+		e.launchConfigurations = *list
+		sort.Slice(e.launchConfigurations, func(i, j int) bool {
+			return e.launchConfigurations[i].Region+e.launchConfigurations[i].Name < e.launchConfigurations[j].Region+e.launchConfigurations[j].Name
+		})
+		for _, l := range e.launchConfigurations {
+			e.refs[l.Region+"/lc/"+l.Name] = e.register("aws_launch_configuration", l.Name, l.Region+"/"+l.Name) + ".name"
+		}
+
+	case "autoscalegroups":
+		var list *AutoScaleGroups
+		list, errs = GetAutoScaleGroups(search)
+		e.autoScaleGroups = *list
+		sort.Slice(e.autoScaleGroups, func(i, j int) bool {
+			return e.autoScaleGroups[i].Region+e.autoScaleGroups[i].Name < e.autoScaleGroups[j].Region+e.autoScaleGroups[j].Name
+		})
+		for _, a := range e.autoScaleGroups {
+			e.register("aws_autoscaling_group", a.Name, a.Region+"/"+a.Name)
+		}
+
+	case "instances":
+		var list *Instances
+		list, errs = GetInstances(search, false)
+
+		// Terminated instances can not be imported
+		for _, i := range *list {
+			if i.State != "terminated" && i.State != "shutting-down" {
+				e.instances = append(e.instances, i)
+			}
+		}
+		sort.Slice(e.instances, func(i, j int) bool {
+			return e.instances[i].Region+e.instances[i].InstanceID < e.instances[j].Region+e.instances[j].InstanceID
+		})
+		for _, i := range e.instances {
+			e.refs[i.InstanceID] = e.register("aws_instance", i.Name, i.InstanceID) + ".id"
+		}
+
+	case "volumes":
+		var list *Volumes
+		list, errs = GetVolumes(search, false)
+
+		// Deleted volumes can not be imported
+		for _, v := range *list {
+			if v.State != "deleting" && v.State != "deleted" {
+				e.volumes = append(e.volumes, v)
+			}
+		}
+		sort.Slice(e.volumes, func(i, j int) bool {
+			return e.volumes[i].Region+e.volumes[i].VolumeID < e.volumes[j].Region+e.volumes[j].VolumeID
+		})
+		for _, v := range e.volumes {
+			e.refs[v.VolumeID] = e.register("aws_ebs_volume", v.Name, v.VolumeID) + ".id"
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New("Error gathering " + assetType + " list")
+	}
+
+	return nil
+}
+
+// register reserves a unique resource name of a resource type, built from the assets name or id, and returns the resource address
+func (e *tfExport) register(resourceType, name, id string) tfExpr {
+	if name == "" {
+		name = id
+	}
+
+	base := tfName(name)
+	name = base
+	for i := 2; e.names[resourceType+"."+name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	e.names[resourceType+"."+name] = true
+
+	e.refs[resourceType+"/"+id] = tfExpr(resourceType + "." + name)
+
+	return tfExpr(resourceType + "." + name)
+}
+
+// resource adds a resource block and its matching import block to the export
+func (e *tfExport) resource(resourceType, id, importID, region string, attrs []tfAttr, blocks []tfBlock) {
+	address := string(e.refs[resourceType+"/"+id])
+	name := strings.TrimPrefix(address, resourceType+".")
+	provider := tfExpr("aws." + tfProviderAlias(region))
+
+	e.regions[region] = true
+
+	e.blocks = append(e.blocks, tfBlock{
+		kind:   "resource",
+		labels: []string{resourceType, name},
+		attrs:  append([]tfAttr{{"provider", provider}}, attrs...),
+		blocks: blocks,
+	})
+
+	e.imports = append(e.imports, tfBlock{
+		kind: "import",
+		attrs: []tfAttr{
+			{"provider", provider},
+			{"to", tfExpr(address)},
+			{"id", importID},
+		},
+	})
+}
+
+// ref returns a reference to an exported resource, or the literal value if it is not part of the export
+func (e *tfExport) ref(key, value string) interface{} {
+	if ref, ok := e.refs[key]; ok {
+		return ref
+	}
+	return value
+}
+
+// refList returns a list of references to exported resources, falling back to literal values for the rest
+func (e *tfExport) refList(prefix string, values []string) []interface{} {
+	list := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		list = append(list, e.ref(prefix+value, value))
+	}
+	return list
+}
+
+// add writes the resources of a single asset type
+func (e *tfExport) add(assetType string) {
+	switch assetType {
+	case "vpcs":
+		for _, v := range e.vpcs {
+			e.resource("aws_vpc", v.VpcID, v.VpcID, v.Region, []tfAttr{
+				{"cidr_block", v.CIDRBlock},
+				{"instance_tenancy", v.Tenancy},
+				{"tags", tfTags(v.Name, v.Class)},
+			}, nil)
+		}
+
+	case "subnets":
+		for _, s := range e.subnets {
+			e.resource("aws_subnet", s.SubnetID, s.SubnetID, s.Region, []tfAttr{
+				{"vpc_id", e.ref(s.VpcID, s.VpcID)},
+				{"cidr_block", s.CIDRBlock},
+				{"availability_zone", s.AvailabilityZone},
+				{"map_public_ip_on_launch", s.MapPublicIP},
+				{"tags", tfTags(s.Name, s.Class)},
+			}, nil)
+		}
+
+	case "securitygroups":
+		for _, s := range e.securityGroups {
+			var rules []tfBlock
+			for _, grant := range s.SecurityGroupGrants {
+				rules = append(rules, e.securityGroupRule(s, grant))
+			}
+
+			attrs := []tfAttr{
+				{"name", s.GroupName},
+				{"description", s.Description},
+			}
+			if s.VpcID != "" {
+				attrs = append(attrs, tfAttr{"vpc_id", e.ref(s.VpcID, s.VpcID)})
+			}
+			attrs = append(attrs, tfAttr{"tags", tfTags(s.Name, s.Class)})
+
+			e.resource("aws_security_group", s.GroupID, s.GroupID, s.Region, attrs, rules)
+		}
+
+	case "loadbalancers":
+		for _, l := range e.loadBalancers {
+			attrs := []tfAttr{
+				{"name", l.Name},
+				{"internal", l.Scheme == "internal"},
+			}
+			if len(l.SubnetIDs) > 0 {
+				attrs = append(attrs, tfAttr{"subnets", e.refList("", l.SubnetIDs)})
+			} else {
+				attrs = append(attrs, tfAttr{"availability_zones", l.AvailabilityZones})
+			}
+			if len(l.SecurityGroupIDs) > 0 {
+				attrs = append(attrs, tfAttr{"security_groups", e.refList("", l.SecurityGroupIDs)})
+			}
+			attrs = append(attrs,
+				tfAttr{"cross_zone_load_balancing", l.LoadBalancerAttributes.CrossZoneLoadBalancingEnabled},
+				tfAttr{"idle_timeout", l.LoadBalancerAttributes.IdleTimeout},
+				tfAttr{"connection_draining", l.LoadBalancerAttributes.ConnectionDrainingEnabled},
+				tfAttr{"connection_draining_timeout", l.LoadBalancerAttributes.ConnectionDrainingTimeout},
+				tfAttr{"tags", tfTags("", l.Class)},
+			)
+
+			var blocks []tfBlock
+			for _, listener := range l.LoadBalancerListeners {
+				listenerAttrs := []tfAttr{
+					{"instance_port", listener.InstancePort},
+					{"instance_protocol", strings.ToLower(listener.InstanceProtocol)},
+					{"lb_port", listener.LoadBalancerPort},
+					{"lb_protocol", strings.ToLower(listener.Protocol)},
+				}
+				if listener.SSLCertificateID != "" {
+					listenerAttrs = append(listenerAttrs, tfAttr{"ssl_certificate_id", listener.SSLCertificateID})
+				}
+				blocks = append(blocks, tfBlock{kind: "listener", attrs: listenerAttrs})
+			}
+
+			healthCheck := l.LoadBalancerHealthCheck
+			blocks = append(blocks, tfBlock{
+				kind: "health_check",
+				attrs: []tfAttr{
+					{"target", healthCheck.HealthCheckTarget},
+					{"timeout", healthCheck.HealthCheckTimeout},
+					{"interval", healthCheck.HealthCheckInterval},
+					{"healthy_threshold", healthCheck.HealthCheckHealthyThreshold},
+					{"unhealthy_threshold", healthCheck.HealthCheckUnhealthyThreshold},
+				},
+			})
+
+			if l.LoadBalancerAttributes.AccessLogEnabled {
+				blocks = append(blocks, tfBlock{
+					kind: "access_logs",
+					attrs: []tfAttr{
+						{"bucket", l.LoadBalancerAttributes.AccessLogS3BucketName},
+						{"bucket_prefix", l.LoadBalancerAttributes.AccessLogS3BucketPrefix},
+						{"interval", l.LoadBalancerAttributes.AccessLogEmitInterval},
+					},
+				})
+			}
+
+			e.resource("aws_elb", l.Region+"/"+l.Name, l.Name, l.Region, attrs, blocks)
+		}
+
+	case "launchconfigurations":
+		for _, l := range e.launchConfigurations {
+			attrs := []tfAttr{
+				{"name", l.Name},
+				{"image_id", l.ImageID},
+				{"instance_type", l.InstanceType},
+			}
+			if l.KeyName != "" {
+				attrs = append(attrs, tfAttr{"key_name", l.KeyName})
+			}
+			attrs = append(attrs,
+				tfAttr{"security_groups", e.refList("", l.SecurityGroupIDs)},
+				tfAttr{"ebs_optimized", l.EbsOptimized},
+			)
+			if l.IamInstanceProfile != "" {
+				attrs = append(attrs, tfAttr{"iam_instance_profile", l.IamInstanceProfile})
+			}
+
+			// Launch Configurations can not be changed, so anything left out here would replace them on the first plan
+			if l.UserData != "" {
+				userData, err := base64.StdEncoding.DecodeString(l.UserData)
+				if err == nil && utf8.Valid(userData) {
+					attrs = append(attrs, tfAttr{"user_data", string(userData)})
+				} else {
+					attrs = append(attrs, tfAttr{"user_data_base64", l.UserData})
+				}
+			}
+
+			var blocks []tfBlock
+			for _, device := range l.BlockDevices {
+				switch {
+				case device.VirtualName != "":
+					blocks = append(blocks, tfBlock{
+						kind: "ephemeral_block_device",
+						attrs: []tfAttr{
+							{"device_name", device.DeviceName},
+							{"virtual_name", device.VirtualName},
+						},
+					})
+
+				case device.DeviceName == l.RootDeviceName:
+					deviceAttrs := []tfAttr{
+						{"volume_type", device.VolumeType},
+						{"volume_size", device.VolumeSize},
+						{"delete_on_termination", device.DeleteOnTermination},
+						{"encrypted", device.Encrypted},
+					}
+					if device.Iops > 0 {
+						deviceAttrs = append(deviceAttrs, tfAttr{"iops", device.Iops})
+					}
+					blocks = append(blocks, tfBlock{kind: "root_block_device", attrs: deviceAttrs})
+
+				default:
+					deviceAttrs := []tfAttr{
+						{"device_name", device.DeviceName},
+					}
+					if device.NoDevice {
+						deviceAttrs = append(deviceAttrs, tfAttr{"no_device", true})
+					} else {
+						if device.SnapshotID != "" {
+							deviceAttrs = append(deviceAttrs, tfAttr{"snapshot_id", device.SnapshotID})
+						}
+						deviceAttrs = append(deviceAttrs,
+							tfAttr{"volume_type", device.VolumeType},
+							tfAttr{"volume_size", device.VolumeSize},
+							tfAttr{"delete_on_termination", device.DeleteOnTermination},
+							tfAttr{"encrypted", device.Encrypted},
+						)
+						if device.Iops > 0 {
+							deviceAttrs = append(deviceAttrs, tfAttr{"iops", device.Iops})
+						}
+					}
+					blocks = append(blocks, tfBlock{kind: "ebs_block_device", attrs: deviceAttrs})
+				}
+			}
+
+			e.resource("aws_launch_configuration", l.Region+"/"+l.Name, l.Name, l.Region, attrs, blocks)
+		}
+
+	case "autoscalegroups":
+		for _, a := range e.autoScaleGroups {
+			attrs := []tfAttr{
+				{"name", a.Name},
+				{"launch_configuration", e.ref(a.Region+"/lc/"+a.LaunchConfig, a.LaunchConfig)},
+				{"min_size", a.MinSize},
+				{"max_size", a.MaxSize},
+				{"desired_capacity", a.DesiredCapacity},
+				{"default_cooldown", a.DefaultCooldown},
+				{"health_check_type", a.HealthCheckType},
+				{"health_check_grace_period", a.HealthCheckGracePeriod},
+			}
+			if a.SubnetID != "" {
+				attrs = append(attrs, tfAttr{"vpc_zone_identifier", e.refList("", strings.Split(a.SubnetID, ","))})
+			} else {
+				attrs = append(attrs, tfAttr{"availability_zones", a.AvailabilityZones})
+			}
+			if len(a.LoadBalancers) > 0 {
+				attrs = append(attrs, tfAttr{"load_balancers", e.refList(a.Region+"/elb/", a.LoadBalancers)})
+			}
+
+			var blocks []tfBlock
+			if a.Class != "" {
+				blocks = append(blocks, tfBlock{
+					kind: "tag",
+					attrs: []tfAttr{
+						{"key", "Class"},
+						{"value", a.Class},
+						{"propagate_at_launch", true},
+					},
+				})
+			}
+
+			e.resource("aws_autoscaling_group", a.Region+"/"+a.Name, a.Name, a.Region, attrs, blocks)
+		}
+
+	case "instances":
+		for _, i := range e.instances {
+			attrs := []tfAttr{
+				{"ami", i.AMIID},
+				{"instance_type", i.Size},
+			}
+			if i.KeyPair != "" {
+				attrs = append(attrs, tfAttr{"key_name", i.KeyPair})
+			}
+			if i.SubnetID != "" {
+				attrs = append(attrs,
+					tfAttr{"subnet_id", e.ref(i.SubnetID, i.SubnetID)},
+					tfAttr{"vpc_security_group_ids", e.refList("", i.SecurityGroupIDs)},
+				)
+			} else {
+				attrs = append(attrs, tfAttr{"availability_zone", i.AvailabilityZone})
+			}
+			if i.IamInstanceProfileName != "" {
+				attrs = append(attrs, tfAttr{"iam_instance_profile", i.IamInstanceProfileName})
+			}
+			attrs = append(attrs,
+				tfAttr{"ebs_optimized", i.EbsOptimized},
+				tfAttr{"monitoring", i.Monitoring},
+				tfAttr{"tags", tfTags(i.Name, i.Class)},
+			)
+
+			e.resource("aws_instance", i.InstanceID, i.InstanceID, i.Region, attrs, nil)
+		}
+
+	case "volumes":
+		for _, v := range e.volumes {
+			attrs := []tfAttr{
+				{"availability_zone", v.AvailabilityZone},
+				{"size", v.Size},
+				{"type", v.VolumeType},
+			}
+			if iops, _ := strconv.Atoi(v.Iops); iops > 0 && v.VolumeType != "gp2" {
+				attrs = append(attrs, tfAttr{"iops", iops})
+			}
+			attrs = append(attrs, tfAttr{"encrypted", v.Encrypted})
+			if v.SnapshoID != "" {
+				attrs = append(attrs, tfAttr{"snapshot_id", v.SnapshoID})
+			}
+			attrs = append(attrs, tfAttr{"tags", tfTags(v.Name, v.Class)})
+
+			e.resource("aws_ebs_volume", v.VolumeID, v.VolumeID, v.Region, attrs, nil)
+
+			if v.InstanceID == "" {
+				continue
+			}
+
+			// Attachments are their own resource in Terraform
+			attachmentID := v.VolumeID + "-" + v.InstanceID
+			name := v.Name
+			if name == "" {
+				name = v.VolumeID
+			}
+			e.register("aws_volume_attachment", name+"_attachment", attachmentID)
+			e.resource("aws_volume_attachment", attachmentID, v.Device+":"+v.VolumeID+":"+v.InstanceID, v.Region, []tfAttr{
+				{"device_name", v.Device},
+				{"volume_id", e.ref(v.VolumeID, v.VolumeID)},
+				{"instance_id", e.ref(v.InstanceID, v.InstanceID)},
+			}, nil)
+		}
+	}
+}
+
+// securityGroupRule builds an inline ingress or egress block from a Security Group grant
+func (e *tfExport) securityGroupRule(group SecurityGroup, grant config.SecurityGroupGrant) tfBlock {
+	attrs := []tfAttr{
+		{"from_port", grant.FromPort},
+		{"to_port", grant.ToPort},
+		{"protocol", grant.IPProtocol},
+	}
+
+	if len(grant.CidrIPs) > 0 {
+		attrs = append(attrs, tfAttr{"cidr_blocks", grant.CidrIPs})
+	}
+	if len(grant.CidrIPv6s) > 0 {
+		attrs = append(attrs, tfAttr{"ipv6_cidr_blocks", grant.CidrIPv6s})
+	}
+	if len(grant.PrefixListIDs) > 0 {
+		attrs = append(attrs, tfAttr{"prefix_list_ids", grant.PrefixListIDs})
+	}
+
+	var sources []interface{}
+	for _, name := range grant.SourceSecurityGroupNames {
+		if name == "" {
+			continue
+		}
+		if name == group.Name || name == group.GroupName {
+			attrs = append(attrs, tfAttr{"self", true})
+			continue
+		}
+		id := e.securityGroupID(group.Region, group.VpcID, name)
+		sources = append(sources, e.ref(id, id))
+	}
+	if len(sources) > 0 {
+		attrs = append(attrs, tfAttr{"security_groups", sources})
+	}
+
+	if grant.Note != "" {
+		attrs = append(attrs, tfAttr{"description", grant.Note})
+	}
+
+	return tfBlock{kind: grant.Type, attrs: attrs}
+}
+
+// securityGroupID returns the id of a Security Group by name, since grants only carry the names of their source groups
+func (e *tfExport) securityGroupID(region, vpcID, name string) string {
+	key := region + "/" + vpcID
+	if _, ok := e.secGrpIDs[key]; !ok {
+		ids := make(map[string]string)
+		secGrpList := new(SecurityGroups)
+		GetRegionSecurityGroups(region, secGrpList, "")
+		for _, s := range *secGrpList {
+			if s.VpcID == vpcID {
+				ids[s.Name] = s.GroupID
+				ids[s.GroupName] = s.GroupID
+			}
+		}
+		e.secGrpIDs[key] = ids
+	}
+
+	if id, ok := e.secGrpIDs[key][name]; ok {
+		return id
+	}
+	return name
+}
+
+// tfTags returns the Name and Class tags of an asset, leaving out empty ones
+func tfTags(name, class string) map[string]string {
+	tags := make(map[string]string)
+	if name != "" {
+		tags["Name"] = name
+	}
+	if class != "" {
+		tags["Class"] = class
+	}
+	return tags
+}
+
+// tfName builds a Terraform resource name from an asset name, which may contain characters that resource names can not
+func tfName(name string) string {
+	var out []rune
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' || r == '-' {
+			out = append(out, r)
+		} else {
+			out = append(out, '_')
+		}
+	}
+
+	if len(out) == 0 || (out[0] >= '0' && out[0] <= '9') || out[0] == '-' {
+		out = append([]rune{'_'}, out...)
+	}
+
+	return string(out)
+}
+
+// tfProviderAlias returns the provider alias for a region, like us_east_1
+func tfProviderAlias(region string) string {
+	return strings.Replace(region, "-", "_", -1)
+}
+
+// tfString quotes a string for Terraform, escaping template sequences as well. Only the escapes HCL understands are used, other
+// control characters are written as \uXXXX
+func tfString(s string) string {
+	var buf bytes.Buffer
+	buf.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&buf, "\\u%04X", r)
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+	buf.WriteByte('"')
+
+	quoted := buf.String()
+	quoted = strings.Replace(quoted, "${", "$${", -1)
+	quoted = strings.Replace(quoted, "%{", "%%{", -1)
+	return quoted
+}
+
+// tfValue formats an attribute value for Terraform
+func tfValue(value interface{}, indent int) string {
+	switch v := value.(type) {
+	case tfExpr:
+		return string(v)
+	case string:
+		return tfString(v)
+	case int:
+		return strconv.Itoa(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case []string:
+		list := make([]interface{}, len(v))
+		for i, s := range v {
+			list[i] = s
+		}
+		return tfValue(list, indent)
+	case []interface{}:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = tfValue(item, indent)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case map[string]string:
+		if len(v) == 0 {
+			return "{}"
+		}
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		pad := 0
+		for _, key := range keys {
+			if len(key) > pad {
+				pad = len(key)
+			}
+		}
+
+		prefix := strings.Repeat("  ", indent+1)
+		out := "{\n"
+		for _, key := range keys {
+			out += fmt.Sprintf("%s%-*s = %s\n", prefix, pad, key, tfString(v[key]))
+		}
+		return out + strings.Repeat("  ", indent) + "}"
+	}
+
+	return tfString(fmt.Sprint(value))
+}
+
+// write formats a block the way terraform fmt would, with the equals signs of the attributes lined up
+func (b tfBlock) write(buf *bytes.Buffer, indent int) {
+	prefix := strings.Repeat("  ", indent)
+
+	buf.WriteString(prefix + b.kind)
+	for _, label := range b.labels {
+		buf.WriteString(" " + tfString(label))
+	}
+	buf.WriteString(" {\n")
+
+	pad := 0
+	for _, attr := range b.attrs {
+		if len(attr.name) > pad {
+			pad = len(attr.name)
+		}
+	}
+
+	for _, attr := range b.attrs {
+		buf.WriteString(fmt.Sprintf("%s  %-*s = %s\n", prefix, pad, attr.name, tfValue(attr.value, indent+1)))
+	}
+
+	for _, block := range b.blocks {
+		buf.WriteString("\n")
+		block.write(buf, indent+1)
+	}
+
+	buf.WriteString(prefix + "}\n")
+}
@@ -0,0 +1,46 @@
+package aws
+
+import "testing"
+
+func TestTfName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "web-app", want: "web-app"},
+		{name: "Web App", want: "web_app"},
+		{name: "prod.v2", want: "prod_v2"},
+		{name: "1st", want: "_1st"},
+		{name: "-web", want: "_-web"},
+		{name: "héllo", want: "h_llo"},
+		{name: "", want: "_"},
+	}
+
+	for _, test := range tests {
+		if got := tfName(test.name); got != test.want {
+			t.Errorf("tfName(%q) = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func TestTfString(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{s: "plain", want: `"plain"`},
+		{s: `say "hi"`, want: `"say \"hi\""`},
+		{s: `C:\awsm`, want: `"C:\\awsm"`},
+		{s: "one\ntwo\r\tthree", want: `"one\ntwo\r\tthree"`},
+		{s: "\x00\x1b\x7f", want: `"\u0000\u001B\u007F"`},
+		{s: "héllo", want: `"héllo"`},
+		{s: "${var.name}", want: `"$${var.name}"`},
+		{s: "%{if true}", want: `"%%{if true}"`},
+	}
+
+	for _, test := range tests {
+		if got := tfString(test.s); got != test.want {
+			t.Errorf("tfString(%q) = %s, want %s", test.s, got, test.want)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -670,6 +671,221 @@ func attachVolume(volume Volume, volCfg config.VolumeClass, instance Instance, s
 	return nil
 }
 
+// ModifyVolume changes the size, type and/or IOPS of an EBS Volume, and optionally grows its filesystem and updates its class
+func ModifyVolume(search string, size int, volumeType string, iops int, grow, updateClass, dryRun bool) error {
+
+	// --dry-run flag
+	if dryRun {
+		terminal.Information("--dry-run flag is set, not making any actual changes!")
+	}
+
+	if size == 0 && volumeType == "" && iops == 0 {
+		return errors.New("Please provide at least one of --size, --type or --iops to modify.")
+	}
+
+	// Get the volume
+	volList, _ := GetVolumes(search, false)
+	volCount := len(*volList)
+	if volCount == 0 {
+		return errors.New("No volumes found for your search terms.")
+	} else if volCount > 1 {
+		volList.PrintTable()
+		return errors.New("Please limit your search terms to return only one volume.")
+	}
+
+	volume := (*volList)[0]
+
+	if size != 0 && size < volume.Size {
+		return fmt.Errorf("Volume [%s] is [%d GB], EBS Volumes can only be grown!", volume.VolumeID, volume.Size)
+	}
+
+	var volCfg config.VolumeClass
+	var err error
+	if grow || updateClass {
+		if volume.Class == "" {
+			return errors.New("Volume [" + volume.VolumeID + "] does not have a Class associated with it.")
+		}
+
+		// Class Config
+		volCfg, err = config.LoadVolumeClass(volume.Class)
+		if err != nil {
+			return err
+		}
+	}
+
+	volList.PrintTable()
+
+	// Check if we are able to send SSM commands to this instance, if needed
+	runCmd := false
+	var ssmInstance SSMInstance
+	if grow {
+		if volCfg.GrowCommand == "" {
+			return errors.New("Volume Class [" + volume.Class + "] does not have a Grow Command configured.")
+		}
+		if volume.InstanceID == "" {
+			return errors.New("Volume [" + volume.VolumeID + "] is not attached to an instance, unable to grow its filesystem.")
+		}
+
+		terminal.Information("Volume Class [" + volume.Class + "] has an SSM Grow Command configured, checking if we are able to send it...")
+
+		ssmInstance, err = GetSSMInstanceById(volume.Region, volume.InstanceID)
+		if err != nil || ssmInstance.InstanceID == "" {
+			if err != nil {
+				terminal.ErrorLine(err.Error() + " No SSM Grow Command will be run on this instance!")
+			} else {
+				terminal.ErrorLine("Instance [" + volume.InstanceID + "] is not managed by SSM. No SSM Grow Command will be run on this instance!")
+			}
+
+			// Confirm continue if we can't run it.
+			if !terminal.PromptBool("Do you want to continue without growing the filesystem?") {
+				return errors.New("Aborting!")
+			}
+		} else {
+			runCmd = true
+			terminal.Information("Found SSM Instance [" + ssmInstance.InstanceID + "] named [" + ssmInstance.ComputerName + "] and a ping time of [" + humanize.Time(ssmInstance.LastPingDateTime) + "]!")
+		}
+	}
+
+	// Confirm
+	if !terminal.PromptBool("Are you sure you want to modify this Volume?") {
+		return errors.New("Aborting!")
+	}
+
+	// Modify it
+	err = modifyVolume(volume, size, volumeType, iops, dryRun)
+	if err != nil {
+		return err
+	}
+
+	// Run the Grow Command on the Instance
+	if runCmd {
+		terminal.Delta("Running Grow Command...")
+		invocations, err := runCommand(&SSMInstances{ssmInstance}, volCfg.GrowCommand, dryRun)
+		if err != nil {
+			return err
+		}
+		invocations.PrintOutput()
+	}
+
+	// Update the class
+	if updateClass {
+		if size != 0 {
+			volCfg.VolumeSize = size
+		}
+		if volumeType != "" {
+			volCfg.VolumeType = volumeType
+		}
+		if iops != 0 {
+			volCfg.Iops = iops
+		}
+
+		if !dryRun {
+			err = config.Insert("volumes", config.VolumeClasses{volume.Class: volCfg})
+			if err != nil {
+				return err
+			}
+		}
+
+		terminal.Delta("Updated Volume Class [" + volume.Class + "]!")
+	}
+
+	terminal.Information("Done!")
+	return nil
+}
+
+// Private function without the confirmation terminal prompts
+func modifyVolume(volume Volume, size int, volumeType string, iops int, dryRun bool) error {
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(volume.Region)}))
+	svc := ec2.New(sess)
+
+	params := &ec2.ModifyVolumeInput{
+		VolumeId: aws.String(volume.VolumeID),
+		DryRun:   aws.Bool(dryRun),
+	}
+
+	if size != 0 {
+		params.SetSize(int64(size))
+	}
+	if volumeType != "" {
+		params.SetVolumeType(volumeType)
+	}
+	if iops != 0 {
+		params.SetIops(int64(iops))
+	}
+
+	terminal.Delta("Modifying Volume [" + volume.VolumeID + "] named [" + volume.Name + "] in [" + volume.Region + "]...")
+
+	_, err := svc.ModifyVolume(params)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == "DryRunOperation" {
+				return nil
+			}
+			return errors.New(awsErr.Message())
+		}
+		return err
+	}
+
+	terminal.Notice("Waiting for the Volume modification to be applied...")
+
+	return waitForVolumeModification(volume.VolumeID, volume.Region)
+}
+
+// volumeModificationTimeout is how long to wait for a Volume modification to make the new size usable
+const volumeModificationTimeout = 30 * time.Minute
+
+// waitForVolumeModification polls the modification state of a Volume until the new size is usable (optimizing) or it completes,
+// giving up after volumeModificationTimeout
+func waitForVolumeModification(volumeID, region string) error {
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := ec2.New(sess)
+
+	lastProgress := int64(-1)
+	deadline := time.Now().Add(volumeModificationTimeout)
+
+	for {
+		resp, err := svc.DescribeVolumesModifications(&ec2.DescribeVolumesModificationsInput{
+			VolumeIds: []*string{aws.String(volumeID)},
+		})
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok {
+				return errors.New(awsErr.Message())
+			}
+			return err
+		}
+
+		if len(resp.VolumesModifications) == 0 {
+			return errors.New("No modifications found for Volume [" + volumeID + "]!")
+		}
+
+		modification := resp.VolumesModifications[0]
+		state := aws.StringValue(modification.ModificationState)
+		progress := aws.Int64Value(modification.Progress)
+
+		if progress != lastProgress {
+			terminal.Notice(fmt.Sprintf("Volume [%s] modification is [%s] - %d%%", volumeID, state, progress))
+			lastProgress = progress
+		}
+
+		switch state {
+		case "optimizing", "completed":
+			terminal.Information("Volume [" + volumeID + "] modification is [" + state + "], the new size is available!")
+			return nil
+
+		case "failed":
+			return errors.New("Volume [" + volumeID + "] modification failed: " + aws.StringValue(modification.StatusMessage))
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("Volume [%s] modification was still [%s] after %s", volumeID, state, volumeModificationTimeout)
+		}
+
+		time.Sleep(5 * time.Second)
+	}
+}
+
 // CreateVolume creates a new EBS Volume
 func CreateVolume(class, name, az string, dryRun bool) error {
 
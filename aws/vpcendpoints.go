@@ -0,0 +1,406 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/murdinc/awsm/aws/regions"
+	"github.com/murdinc/awsm/models"
+	"github.com/murdinc/terminal"
+	"github.com/olekukonko/tablewriter"
+)
+
+// VpcEndpoints represents a slice of VPC Endpoints
+type VpcEndpoints []VpcEndpoint
+
+// VpcEndpoint represents a single VPC Endpoint
+type VpcEndpoint models.VpcEndpoint
+
+// GetVpcEndpoints returns a slice of VPC Endpoints that match the provided search term
+func GetVpcEndpoints(search string) (*VpcEndpoints, []error) {
+	var wg sync.WaitGroup
+	var errs []error
+
+	endpointList := new(VpcEndpoints)
+	regions := GetRegionListWithoutIgnored()
+
+	for _, region := range regions {
+		wg.Add(1)
+
+		go func(region *ec2.Region) {
+			defer wg.Done()
+			err := GetRegionVpcEndpoints(*region.RegionName, endpointList, search)
+			if err != nil {
+				terminal.ShowErrorMessage(fmt.Sprintf("Error gathering vpc endpoint list for region [%s]", *region.RegionName), err.Error())
+				errs = append(errs, err)
+			}
+		}(region)
+	}
+	wg.Wait()
+
+	return endpointList, errs
+}
+
+// GetRegionVpcEndpoints returns a list of a regions VPC Endpoints into the provided VpcEndpoints slice
+func GetRegionVpcEndpoints(region string, endpointList *VpcEndpoints, search string) error {
+
+	// Validate the region
+	if !regions.ValidRegion(region) {
+		return errors.New("Region [" + region + "] is Invalid!")
+	}
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := ec2.New(sess)
+
+	result, err := svc.DescribeVpcEndpoints(&ec2.DescribeVpcEndpointsInput{})
+	if err != nil {
+		return err
+	}
+
+	vpcList := new(Vpcs)
+	GetRegionVpcs(region, vpcList, "")
+
+	endpoints := make(VpcEndpoints, len(result.VpcEndpoints))
+	for i, endpoint := range result.VpcEndpoints {
+		endpoints[i].Marshal(endpoint, region, vpcList)
+	}
+
+	if search != "" {
+		term := regexp.MustCompile(search)
+	Loop:
+		for i, e := range endpoints {
+			rEndpoint := reflect.ValueOf(e)
+
+			for k := 0; k < rEndpoint.NumField(); k++ {
+				sVal := rEndpoint.Field(k).String()
+
+				if term.MatchString(sVal) {
+					*endpointList = append(*endpointList, endpoints[i])
+					continue Loop
+				}
+			}
+		}
+	} else {
+		*endpointList = append(*endpointList, endpoints[:]...)
+	}
+
+	return nil
+}
+
+// getVpcEndpointsByVpcID returns a slice of the VPC Endpoints that belong to the provided VPC ID
+func getVpcEndpointsByVpcID(vpcId, region string) (VpcEndpoints, error) {
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := ec2.New(sess)
+
+	result, err := svc.DescribeVpcEndpoints(&ec2.DescribeVpcEndpointsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name: aws.String("vpc-id"),
+				Values: []*string{
+					aws.String(vpcId),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return VpcEndpoints{}, err
+	}
+
+	endpoints := make(VpcEndpoints, len(result.VpcEndpoints))
+	for i, endpoint := range result.VpcEndpoints {
+		endpoints[i].Marshal(endpoint, region, &Vpcs{})
+	}
+
+	return endpoints, nil
+}
+
+// Marshal parses the response from the aws sdk into an awsm VPC Endpoint
+func (e *VpcEndpoint) Marshal(endpoint *ec2.VpcEndpoint, region string, vpcList *Vpcs) {
+	e.VpcEndpointID = aws.StringValue(endpoint.VpcEndpointId)
+	e.VpcEndpointType = aws.StringValue(endpoint.VpcEndpointType)
+	e.ServiceName = aws.StringValue(endpoint.ServiceName)
+	e.State = aws.StringValue(endpoint.State)
+	e.VpcID = aws.StringValue(endpoint.VpcId)
+	e.VpcName = vpcList.GetVpcName(e.VpcID)
+	e.RouteTables = aws.StringValueSlice(endpoint.RouteTableIds)
+	e.Subnets = aws.StringValueSlice(endpoint.SubnetIds)
+	e.PrivateDNS = aws.BoolValue(endpoint.PrivateDnsEnabled)
+	e.CreationTime = aws.TimeValue(endpoint.CreationTimestamp)
+	e.Region = region
+}
+
+// PrintTable Prints an ascii table of the list of VPC Endpoints
+func (e *VpcEndpoints) PrintTable() {
+	if len(*e) == 0 {
+		terminal.ShowErrorMessage("Warning", "No VPC Endpoints Found!")
+		return
+	}
+
+	var header []string
+	rows := make([][]string, len(*e))
+
+	for index, endpoint := range *e {
+		models.ExtractAwsmTable(index, endpoint, &header, &rows)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(header)
+	table.AppendBulk(rows)
+	table.Render()
+}
+
+// vpcEndpointType returns the endpoint type of an aws service, only s3 and dynamodb are gateway endpoints
+func vpcEndpointType(service string) string {
+	switch service {
+	case "s3", "dynamodb":
+		return "Gateway"
+	}
+	return "Interface"
+}
+
+// createVpcEndpoints creates a VPC Endpoint for each of the provided services. Gateway endpoints are associated
+// with the existing route tables of the VPC, and Interface endpoints with one existing subnet per availability zone.
+func createVpcEndpoints(vpcId string, services []string, region string, dryRun bool) error {
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := ec2.New(sess)
+
+	routeTables, err := GetVpcRouteTables("", vpcId, region)
+	if err != nil {
+		return err
+	}
+
+	subnets, err := GetSubnetsByVpcID(vpcId, region)
+	if err != nil {
+		return err
+	}
+
+	for _, service := range services {
+
+		endpointType := vpcEndpointType(service)
+
+		params := &ec2.CreateVpcEndpointInput{
+			ServiceName:     aws.String("com.amazonaws." + region + "." + service),
+			VpcEndpointType: aws.String(endpointType),
+			VpcId:           aws.String(vpcId),
+			DryRun:          aws.Bool(dryRun),
+		}
+
+		switch endpointType {
+		case "Gateway":
+			var routeTableIds []string
+			for _, rt := range *routeTables {
+				routeTableIds = append(routeTableIds, rt.RouteTableID)
+			}
+			params.SetRouteTableIds(aws.StringSlice(routeTableIds))
+
+		case "Interface":
+			// Interface endpoints can only have one subnet per availability zone
+			azs := make(map[string]bool)
+			var subnetIds []string
+			for _, subnet := range subnets {
+				if !azs[subnet.AvailabilityZone] {
+					azs[subnet.AvailabilityZone] = true
+					subnetIds = append(subnetIds, subnet.SubnetID)
+				}
+			}
+			if len(subnetIds) > 0 {
+				params.SetSubnetIds(aws.StringSlice(subnetIds))
+			}
+			params.SetPrivateDnsEnabled(true)
+		}
+
+		createEndpointResp, err := svc.CreateVpcEndpoint(params)
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok {
+				if awsErr.Code() == "DryRunOperation" {
+					continue
+				}
+				return errors.New(awsErr.Message())
+			}
+			return err
+		}
+
+		terminal.Delta("Created " + endpointType + " VPC Endpoint [" + aws.StringValue(createEndpointResp.VpcEndpoint.VpcEndpointId) + "] for [" + service + "] in [" + region + "]!")
+	}
+
+	return nil
+}
+
+// addRouteTableToVpcEndpoints associates a Route Table with all of the Gateway endpoints of its VPC
+func addRouteTableToVpcEndpoints(routeTableId, vpcId, region string, dryRun bool) error {
+
+	endpoints, err := getVpcEndpointsByVpcID(vpcId, region)
+	if err != nil {
+		return err
+	}
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := ec2.New(sess)
+
+	for _, endpoint := range endpoints {
+		if endpoint.VpcEndpointType != "Gateway" {
+			continue
+		}
+
+		params := &ec2.ModifyVpcEndpointInput{
+			VpcEndpointId:    aws.String(endpoint.VpcEndpointID),
+			AddRouteTableIds: []*string{aws.String(routeTableId)},
+			DryRun:           aws.Bool(dryRun),
+		}
+
+		_, err := svc.ModifyVpcEndpoint(params)
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok {
+				if awsErr.Code() == "DryRunOperation" {
+					continue
+				}
+				return errors.New(awsErr.Message())
+			}
+			return err
+		}
+
+		terminal.Delta("Associated Route Table [" + routeTableId + "] to VPC Endpoint [" + endpoint.VpcEndpointID + "] for [" + endpoint.ServiceName + "]!")
+	}
+
+	return nil
+}
+
+// addSubnetToVpcEndpoints adds a Subnet to the Interface endpoints of its VPC that are not yet in its availability zone
+func addSubnetToVpcEndpoints(subnetId, az, vpcId, region string, dryRun bool) error {
+
+	endpoints, err := getVpcEndpointsByVpcID(vpcId, region)
+	if err != nil {
+		return err
+	}
+
+	subnets, err := GetSubnetsByVpcID(vpcId, region)
+	if err != nil {
+		return err
+	}
+
+	subnetAzs := make(map[string]string)
+	for _, subnet := range subnets {
+		subnetAzs[subnet.SubnetID] = subnet.AvailabilityZone
+	}
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(region)}))
+	svc := ec2.New(sess)
+
+Loop:
+	for _, endpoint := range endpoints {
+		if endpoint.VpcEndpointType != "Interface" {
+			continue
+		}
+
+		for _, endpointSubnet := range endpoint.Subnets {
+			if subnetAzs[endpointSubnet] == az {
+				continue Loop
+			}
+		}
+
+		params := &ec2.ModifyVpcEndpointInput{
+			VpcEndpointId: aws.String(endpoint.VpcEndpointID),
+			AddSubnetIds:  []*string{aws.String(subnetId)},
+			DryRun:        aws.Bool(dryRun),
+		}
+
+		_, err := svc.ModifyVpcEndpoint(params)
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok {
+				if awsErr.Code() == "DryRunOperation" {
+					continue
+				}
+				return errors.New(awsErr.Message())
+			}
+			return err
+		}
+
+		terminal.Delta("Added Subnet [" + subnetId + "] to VPC Endpoint [" + endpoint.VpcEndpointID + "] for [" + endpoint.ServiceName + "]!")
+	}
+
+	return nil
+}
+
+// DeleteVpcEndpoints deletes one or more VPC Endpoints that match the provided search term and optional region
+func DeleteVpcEndpoints(search, region string, dryRun bool) (err error) {
+
+	// --dry-run flag
+	if dryRun {
+		terminal.Information("--dry-run flag is set, not making any actual changes!")
+	}
+
+	endpointList := new(VpcEndpoints)
+
+	// Check if we were given a region or not
+	if region != "" {
+		err = GetRegionVpcEndpoints(region, endpointList, search)
+	} else {
+		endpointList, _ = GetVpcEndpoints(search)
+	}
+
+	if err != nil {
+		return errors.New("Error gathering VPC Endpoint list")
+	}
+
+	if len(*endpointList) > 0 {
+		// Print the table
+		endpointList.PrintTable()
+	} else {
+		return errors.New("No VPC Endpoints found, Aborting!")
+	}
+
+	// Confirm
+	if !terminal.PromptBool("Are you sure you want to delete these VPC Endpoints?") {
+		return errors.New("Aborting!")
+	}
+
+	// Delete 'Em
+	err = deleteVpcEndpoints(endpointList, dryRun)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			return errors.New(awsErr.Message())
+		}
+		return err
+	}
+
+	terminal.Information("Done!")
+
+	return nil
+}
+
+// private function without the confirmation terminal prompts
+func deleteVpcEndpoints(endpointList *VpcEndpoints, dryRun bool) (err error) {
+	for _, endpoint := range *endpointList {
+
+		sess := session.Must(session.NewSession(&aws.Config{Region: aws.String(endpoint.Region)}))
+		svc := ec2.New(sess)
+
+		params := &ec2.DeleteVpcEndpointsInput{
+			VpcEndpointIds: []*string{aws.String(endpoint.VpcEndpointID)},
+			DryRun:         aws.Bool(dryRun),
+		}
+
+		resp, err := svc.DeleteVpcEndpoints(params)
+		if err != nil {
+			return err
+		}
+
+		if len(resp.Unsuccessful) > 0 && resp.Unsuccessful[0].Error != nil {
+			return errors.New(aws.StringValue(resp.Unsuccessful[0].Error.Message))
+		}
+
+		terminal.Delta("Deleted VPC Endpoint [" + endpoint.VpcEndpointID + "] for [" + endpoint.ServiceName + "] in [" + endpoint.Region + "]!")
+	}
+
+	return nil
+}
@@ -1090,6 +1090,33 @@ func CreateVpc(class, name, ip, region string, dryRun bool) error {
 		return err
 	}
 
+	// Create the VPC Endpoints
+	if len(cfg.Endpoints) > 0 {
+
+		// Private DNS on interface endpoints requires DNS hostnames on the VPC
+		for _, service := range cfg.Endpoints {
+			if vpcEndpointType(service) == "Interface" && !dryRun {
+				terminal.Notice("Enabling DNS Hostnames on VPC...")
+
+				_, err = svc.ModifyVpcAttribute(&ec2.ModifyVpcAttributeInput{
+					VpcId:              aws.String(vpcId),
+					EnableDnsHostnames: &ec2.AttributeBooleanValue{Value: aws.Bool(true)},
+				})
+				if err != nil {
+					return err
+				}
+				break
+			}
+		}
+
+		terminal.Notice("Creating VPC Endpoints...")
+
+		err = createVpcEndpoints(vpcId, cfg.Endpoints, region, dryRun)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 
 }
@@ -1229,6 +1256,12 @@ func createRouteTable(name, vpcId, region string, dryRun bool) (string, error) {
 		return rtId, err
 	}
 
+	// Add it to any gateway endpoints in the VPC
+	err = addRouteTableToVpcEndpoints(rtId, vpcId, region, dryRun)
+	if err != nil {
+		return rtId, err
+	}
+
 	return rtId, nil
 }
 
@@ -28,6 +28,75 @@ func main() {
 	var previous bool // optional flag when getting autoscale version
 	var latest bool   // optional flag when getting scaling activities
 	var wait bool     // optional flag when creating snapshots
+	var schedule bool // optional flag when starting the api server
+
+	// optional flags when modifying volumes
+	var size int
+	var volumeType string
+	var iops int
+	var grow bool
+	var updateClass bool
+
+	var revoke bool // optional flag when sharing images and snapshots
+
+	// optional flags when copying and encrypting images and snapshots
+	var encrypt bool
+	var kmsKeyId string
+	var deleteOriginals bool
+
+	// optional flags when getting metrics
+	var assetType string
+	var metric string
+	var statistic string
+	var period int
+	var since string
+	var output string
+
+	// optional flags when tailing logs
+	var stream string
+	var filter string
+	var follow bool
+
+	// optional flags when putting parameters
+	var parameterType string
+	var description string
+	var overwrite bool
+
+	// optional flags when running commands
+	var commandClass string
+	var document string
+
+	// optional flags when creating resource records
+	var setIdentifier string
+	var failover string
+	var latencyRegion string
+	var healthCheck string
+	var weight int
+	var aliasLb string
+	var evaluateTargetHealth bool
+
+	// optional flags when creating health checks
+	var healthCheckType string
+	var interval int
+	var threshold int
+
+	// flags when exporting templates or adopting assets
+	var class string
+	var region string
+
+	// optional flag when planning updates
+	var planOut string
+
+	// optional flag when bootstrapping a new VPC
+	var ip string
+
+	// flag when tearing down a VPC
+	var vpc string
+
+	// optional flags when deploying
+	var version string
+	var bake string
+	var timeout string
 
 	app := cli.NewApp()
 	app.Name = "awsm"
@@ -52,15 +121,62 @@ func main() {
 			Before: setupCheck,
 			Action: func(c *cli.Context) error {
 				terminal.Information("The awsm config looks good!")
+				aws.WarnExpiringCertificates()
 				return nil
 			},
 		},
 		{
-			Name:   "api",
-			Usage:  "Start the awsm api server",
+			Name:  "adopt",
+			Usage: "Adopt an existing asset into a new awsm class",
+			Arguments: []cli.Argument{
+				{
+					Name:        "assetType",
+					Description: "The type of asset to adopt (securitygroups, loadbalancers, instances, volumes, autoscalegroups, vpcs)",
+					Optional:    false,
+				},
+				{
+					Name:        "search",
+					Description: "The search term to find a single asset with",
+					Optional:    false,
+				},
+			},
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "class",
+					Destination: &class,
+					Usage:       "class (The name of the class to create)",
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				if class == "" {
+					return cli.NewExitError("A --class is required!", 1)
+				}
+
+				return aws.Adopt(c.NamedArg("assetType"), c.NamedArg("search"), class, dryRun)
+			},
+		},
+		{
+			Name:  "api",
+			Usage: "Start the awsm api server",
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:        "scheduler",
+					Destination: &schedule,
+					Usage:       "scheduler (Also run scheduled snapshots and images)",
+				},
+			},
 			Before: setupCheck,
 			Action: func(c *cli.Context) error {
-				return api.StartAPI(false)
+				return api.StartAPI(false, schedule, dryRun)
+			},
+		},
+		{
+			Name:   "daemon",
+			Usage:  "Run scheduled snapshots and images",
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				return aws.StartScheduler(dryRun)
 			},
 		},
 		{
@@ -68,7 +184,37 @@ func main() {
 			Usage:  "Launch the awsm Dashboard GUI",
 			Before: setupCheck,
 			Action: func(c *cli.Context) error {
-				return api.StartAPI(true)
+				return api.StartAPI(true, false, dryRun)
+			},
+		},
+		{
+			Name:  "apply",
+			Usage: "Apply an approved plan file",
+			Arguments: []cli.Argument{
+				{
+					Name:        "file",
+					Description: "The plan file to apply",
+					Optional:    false,
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				return aws.ApplyPlan(c.NamedArg("file"), dryRun)
+			},
+		},
+		{
+			Name:  "approvePlan",
+			Usage: "Approve a plan file made by someone else",
+			Arguments: []cli.Argument{
+				{
+					Name:        "file",
+					Description: "The plan file to approve",
+					Optional:    false,
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				return aws.ApprovePlan(c.NamedArg("file"))
 			},
 		},
 		{
@@ -167,6 +313,41 @@ func main() {
 				return nil
 			},
 		},
+		{
+			Name:  "bootstrap",
+			Usage: "Create every missing asset an AutoScaling Group class depends on, in dependency order",
+			Arguments: []cli.Argument{
+				{
+					Name:        "class",
+					Description: "The class of AutoScaling Group to bootstrap",
+					Optional:    false,
+				},
+			},
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "region",
+					Destination: &region,
+					Usage:       "region (The region to bootstrap the AutoScaling Group in)",
+				},
+				cli.StringFlag{
+					Name:        "ip",
+					Destination: &ip,
+					Usage:       "ip (The base IP address of the VPC, only needed if the VPC does not exist yet)",
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				if region == "" {
+					return cli.NewExitError("A --region is required!", 1)
+				}
+
+				err := aws.Bootstrap(c.NamedArg("class"), region, ip, dryRun)
+				if err != nil {
+					return err
+				}
+				return nil
+			},
+		},
 		{
 			Name:  "installKeyPair",
 			Usage: "Installs a Key Pair locally",
@@ -201,9 +382,21 @@ func main() {
 					Optional:    false,
 				},
 			},
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:        "encrypt",
+					Destination: &encrypt,
+					Usage:       "encrypt (Encrypt the copy)",
+				},
+				cli.StringFlag{
+					Name:        "kms-key",
+					Destination: &kmsKeyId,
+					Usage:       "kms-key (KMS Key ID in the destination region to encrypt the copy with, defaults to the account default key)",
+				},
+			},
 			Before: setupCheck,
 			Action: func(c *cli.Context) error {
-				err := aws.CopyImage(c.NamedArg("search"), c.NamedArg("region"), dryRun)
+				err := aws.CopyImage(c.NamedArg("search"), c.NamedArg("region"), encrypt, kmsKeyId, dryRun)
 				if err != nil {
 					return err
 				}
@@ -225,9 +418,21 @@ func main() {
 					Optional:    false,
 				},
 			},
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:        "encrypt",
+					Destination: &encrypt,
+					Usage:       "encrypt (Encrypt the copy)",
+				},
+				cli.StringFlag{
+					Name:        "kms-key",
+					Destination: &kmsKeyId,
+					Usage:       "kms-key (KMS Key ID in the destination region to encrypt the copy with, defaults to the account default key)",
+				},
+			},
 			Before: setupCheck,
 			Action: func(c *cli.Context) error {
-				err := aws.CopySnapshot(c.NamedArg("search"), c.NamedArg("region"), dryRun)
+				err := aws.CopySnapshot(c.NamedArg("search"), c.NamedArg("region"), encrypt, kmsKeyId, dryRun)
 				if err != nil {
 					return err
 				}
@@ -301,6 +506,56 @@ func main() {
 				return nil
 			},
 		},
+		{
+			Name:  "createHealthCheck",
+			Usage: "Create a Route53 Health Check",
+			Arguments: []cli.Argument{
+				{
+					Name:        "name",
+					Description: "The name of the health check",
+					Optional:    false,
+				},
+				{
+					Name:        "target",
+					Description: "The IP Address or domain name to check",
+					Optional:    false,
+				},
+				{
+					Name:        "port",
+					Description: "The port to check (defaults to 80 for HTTP and 443 for HTTPS)",
+					Optional:    true,
+				},
+				{
+					Name:        "path",
+					Description: "The path to request for HTTP and HTTPS checks (/health)",
+					Optional:    true,
+				},
+			},
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "type",
+					Value:       "HTTP",
+					Destination: &healthCheckType,
+					Usage:       "type (HTTP, HTTPS or TCP)",
+				},
+				cli.IntFlag{
+					Name:        "interval",
+					Value:       30,
+					Destination: &interval,
+					Usage:       "interval (Seconds between checks, 10 or 30)",
+				},
+				cli.IntFlag{
+					Name:        "threshold",
+					Value:       3,
+					Destination: &threshold,
+					Usage:       "threshold (Consecutive failures before the target is unhealthy, 1-10)",
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				return aws.CreateHealthCheck(c.NamedArg("name"), c.NamedArg("target"), c.NamedArg("port"), c.NamedArg("path"), healthCheckType, interval, threshold, dryRun)
+			},
+		},
 		{
 			Name:  "createIAMUser",
 			Usage: "Create an IAM User",
@@ -480,6 +735,35 @@ func main() {
 				return nil
 			},
 		},
+		{
+			Name:  "createNetworkAcl",
+			Usage: "Create a VPC Network ACL",
+			Arguments: []cli.Argument{
+				{
+					Name:        "class",
+					Description: "The class of Network ACL to create",
+					Optional:    false,
+				},
+				{
+					Name:        "name",
+					Description: "The name of the Network ACL",
+					Optional:    false,
+				},
+				{
+					Name:        "vpc",
+					Description: "The VPC to create the Network ACL in",
+					Optional:    false,
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				err := aws.CreateNetworkAcl(c.NamedArg("class"), c.NamedArg("name"), c.NamedArg("vpc"), dryRun)
+				if err != nil {
+					return err
+				}
+				return nil
+			},
+		},
 		{
 			Name:  "createResourceRecord",
 			Usage: "Create a Route53 Resource Record",
@@ -511,10 +795,46 @@ func main() {
 					Destination: &private,
 					Usage:       "Use the Private IP, even if a Public IP is available.",
 				},
+				cli.StringFlag{
+					Name:        "set-id",
+					Destination: &setIdentifier,
+					Usage:       "set-id (Set Identifier for weighted, failover and latency record sets)",
+				},
+				cli.IntFlag{
+					Name:        "weight",
+					Value:       -1,
+					Destination: &weight,
+					Usage:       "weight (Create a weighted record set with this weight, 0-255)",
+				},
+				cli.StringFlag{
+					Name:        "failover",
+					Destination: &failover,
+					Usage:       "failover (Create a failover record set, primary or secondary)",
+				},
+				cli.StringFlag{
+					Name:        "latency-region",
+					Destination: &latencyRegion,
+					Usage:       "latency-region (Create a latency record set for this region)",
+				},
+				cli.StringFlag{
+					Name:        "health-check",
+					Destination: &healthCheck,
+					Usage:       "health-check (The search term for a health check to associate with the record set)",
+				},
+				cli.StringFlag{
+					Name:        "alias-lb",
+					Destination: &aliasLb,
+					Usage:       "alias-lb (The search term for a classic or application load balancer to create an alias record for)",
+				},
+				cli.BoolFlag{
+					Name:        "evaluate-target-health",
+					Destination: &evaluateTargetHealth,
+					Usage:       "evaluate-target-health (Route traffic away from the alias record when its load balancer is unhealthy)",
+				},
 			},
 			Before: setupCheck,
 			Action: func(c *cli.Context) error {
-				err := aws.CreateResourceRecord(c.NamedArg("record"), c.NamedArg("value"), c.NamedArg("ttl"), force, private, dryRun)
+				err := aws.CreateResourceRecord(c.NamedArg("record"), c.NamedArg("value"), c.NamedArg("ttl"), aliasLb, setIdentifier, failover, latencyRegion, healthCheck, weight, evaluateTargetHealth, force, private, dryRun)
 				if err != nil {
 					return err
 				}
@@ -815,6 +1135,21 @@ func main() {
 				return nil
 			},
 		},
+		{
+			Name:  "deleteHealthChecks",
+			Usage: "Delete Route53 Health Checks",
+			Arguments: []cli.Argument{
+				{
+					Name:        "search",
+					Description: "The search term for health checks",
+					Optional:    false,
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				return aws.DeleteHealthChecks(c.NamedArg("search"), dryRun)
+			},
+		},
 		{
 			Name:  "deleteIAMInstanceProfiles",
 			Usage: "Delete IAM Instance Profiles",
@@ -1002,6 +1337,54 @@ func main() {
 				return nil
 			},
 		},
+		{
+			Name:  "deleteNetworkAcls",
+			Usage: "Delete VPC Network ACLs",
+			Arguments: []cli.Argument{
+				{
+					Name:        "search",
+					Description: "The search term for Network ACLs to delete",
+					Optional:    false,
+				},
+				{
+					Name:        "region",
+					Description: "The region of the Network ACLs (optional)",
+					Optional:    true,
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				err := aws.DeleteNetworkAcls(c.NamedArg("search"), c.NamedArg("region"), dryRun)
+				if err != nil {
+					return err
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "deleteParameters",
+			Usage: "Delete SSM Parameters",
+			Arguments: []cli.Argument{
+				{
+					Name:        "search",
+					Description: "The search term for parameters to delete",
+					Optional:    false,
+				},
+				{
+					Name:        "region",
+					Description: "The region of the parameters (optional)",
+					Optional:    true,
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				err := aws.DeleteParameters(c.NamedArg("search"), c.NamedArg("region"), dryRun)
+				if err != nil {
+					return err
+				}
+				return nil
+			},
+		},
 		{
 			Name:  "deleteResourceRecords",
 			Usage: "Delete Route53 Resource Records",
@@ -1166,6 +1549,30 @@ func main() {
 				return nil
 			},
 		},
+		{
+			Name:  "deleteVpcEndpoints",
+			Usage: "Delete VPC Endpoints",
+			Arguments: []cli.Argument{
+				{
+					Name:        "search",
+					Description: "The search term for VPC Endpoints to delete",
+					Optional:    false,
+				},
+				{
+					Name:        "region",
+					Description: "The region of the VPC Endpoints (optional)",
+					Optional:    true,
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				err := aws.DeleteVpcEndpoints(c.NamedArg("search"), c.NamedArg("region"), dryRun)
+				if err != nil {
+					return err
+				}
+				return nil
+			},
+		},
 		{
 			Name:  "deleteVpcs",
 			Usage: "Delete VPCs",
@@ -1190,6 +1597,44 @@ func main() {
 				return nil
 			},
 		},
+		{
+			Name:  "deploy",
+			Usage: "Deploy a new Launch Configuration version to an AutoScaling Group class with a parallel AutoScaling Group",
+			Arguments: []cli.Argument{
+				{
+					Name:        "class",
+					Description: "The class of AutoScaling Group to deploy",
+					Optional:    false,
+				},
+			},
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "version",
+					Destination: &version,
+					Usage:       "version (The version of the launch configuration to deploy, defaults to the class version)",
+				},
+				cli.StringFlag{
+					Name:        "bake",
+					Value:       "10m",
+					Destination: &bake,
+					Usage:       "bake (How long the new AutoScaling Group has to stay healthy before the old one is deleted)",
+				},
+				cli.StringFlag{
+					Name:        "timeout",
+					Value:       "15m",
+					Destination: &timeout,
+					Usage:       "timeout (How long to wait for the new instances to be InService before rolling back)",
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				err := aws.Deploy(c.NamedArg("class"), version, bake, timeout, dryRun)
+				if err != nil {
+					return err
+				}
+				return nil
+			},
+		},
 		{
 			Name:  "deregisterInstances",
 			Usage: "Deregister Instances from SSM Inventory",
@@ -1290,62 +1735,188 @@ func main() {
 			},
 		},
 		{
-			Name:  "executeScalingPolicies",
-			Usage: "Execute Scaling Policies",
-			Arguments: []cli.Argument{
-				{
-					Name:        "search",
-					Description: "The search term of the Scaling Policy to execute",
-					Optional:    false,
+			Name:  "drift",
+			Usage: "Compare class-managed assets with their classes",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "type",
+					Destination: &assetType,
+					Usage:       "type (Limit the report to autoscalegroups, instances, volumes, vpcs, subnets, securitygroups or loadbalancers)",
 				},
-				{
+				cli.StringFlag{
 					Name:        "region",
-					Description: "The region to execute the policy in (optional)",
-					Optional:    true,
+					Destination: &region,
+					Usage:       "region (Limit the report to a single region)",
 				},
-			},
-			Flags: []cli.Flag{
-				cli.BoolFlag{
-					Name:        "force",
-					Destination: &force,
-					Usage:       "force (Force deletes all instances and lifecycle actions)",
+				cli.StringFlag{
+					Name:        "output",
+					Value:       "table",
+					Destination: &output,
+					Usage:       "output (table or json)",
 				},
 			},
 			Before: setupCheck,
 			Action: func(c *cli.Context) error {
-				err := aws.ExecuteScalingPolicies(c.NamedArg("search"), c.NamedArg("region"), force, dryRun)
+				drifted, err := aws.PrintDrift(assetType, region, output)
 				if err != nil {
 					return err
 				}
-				return err
+				if drifted {
+					return cli.NewExitError("Drift found!", 1)
+				}
+				return nil
 			},
 		},
 		{
-			Name:  "getLaunchConfigurationVersion",
-			Usage: "Get the current version of a launch configuration",
+			Name:  "encryptSnapshot",
+			Usage: "Replace unencrypted EBS Snapshots with encrypted copies",
 			Arguments: []cli.Argument{
 				{
-					Name:        "class",
-					Description: "The class of the launch configuration",
+					Name:        "search",
+					Description: "The snapshot(s) to encrypt",
 					Optional:    false,
 				},
 			},
 			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "kms-key",
+					Destination: &kmsKeyId,
+					Usage:       "kms-key (KMS Key ID to encrypt with, defaults to the snapshot class key for the region or the account default key)",
+				},
 				cli.BoolFlag{
-					Name:        "previous",
-					Destination: &previous,
-					Usage:       "previous (Returns the previous version of a launch configuration)",
+					Name:        "delete-originals",
+					Destination: &deleteOriginals,
+					Usage:       "delete-originals (Delete the unencrypted originals once their encrypted copies complete)",
 				},
 			},
 			Before: setupCheck,
 			Action: func(c *cli.Context) error {
-				version, err := aws.GetLaunchConfigurationVersion(c.NamedArg("class"), previous)
+				err := aws.EncryptSnapshot(c.NamedArg("search"), kmsKeyId, deleteOriginals, dryRun)
 				if err != nil {
 					return err
 				}
+				return nil
+			},
+		},
+		{
+			Name:  "executeScalingPolicies",
+			Usage: "Execute Scaling Policies",
+			Arguments: []cli.Argument{
+				{
+					Name:        "search",
+					Description: "The search term of the Scaling Policy to execute",
+					Optional:    false,
+				},
+				{
+					Name:        "region",
+					Description: "The region to execute the policy in (optional)",
+					Optional:    true,
+				},
+			},
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:        "force",
+					Destination: &force,
+					Usage:       "force (Force deletes all instances and lifecycle actions)",
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				err := aws.ExecuteScalingPolicies(c.NamedArg("search"), c.NamedArg("region"), force, dryRun)
+				if err != nil {
+					return err
+				}
+				return err
+			},
+		},
+		{
+			Name:  "exportCloudFormation",
+			Usage: "Export a CloudFormation template for an AutoScaling Group class",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "class",
+					Destination: &class,
+					Usage:       "class (The AutoScaling Group class to export)",
+				},
+				cli.StringFlag{
+					Name:        "region",
+					Destination: &region,
+					Usage:       "region (The region to resolve AMIs, snapshots, VPCs and subnets in)",
+				},
+				cli.StringFlag{
+					Name:        "output",
+					Value:       "json",
+					Destination: &output,
+					Usage:       "output (json or yaml)",
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				if class == "" || region == "" {
+					return cli.NewExitError("Both --class and --region are required!", 1)
+				}
+
+				cfnTemplate, err := aws.ExportCloudFormation(class, region, output)
+				if err != nil {
+					return err
+				}
+				fmt.Print(string(cfnTemplate))
+
+				return nil
+			},
+		},
+		{
+			Name:  "exportTerraform",
+			Usage: "Export Terraform resources and import blocks for existing assets",
+			Arguments: []cli.Argument{
+				{
+					Name:        "assetType",
+					Description: "The asset types to export, comma separated (vpcs, subnets, securitygroups, loadbalancers, launchconfigurations, autoscalegroups, instances, volumes)",
+					Optional:    false,
+				},
+				{
+					Name:        "search",
+					Description: "The search term to filter assets with",
+					Optional:    true,
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				tfConfig, err := aws.ExportTerraform(c.NamedArg("assetType"), c.NamedArg("search"))
+				if err != nil {
+					return err
+				}
+				fmt.Print(string(tfConfig))
+
+				return nil
+			},
+		},
+		{
+			Name:  "getLaunchConfigurationVersion",
+			Usage: "Get the current version of a launch configuration",
+			Arguments: []cli.Argument{
+				{
+					Name:        "class",
+					Description: "The class of the launch configuration",
+					Optional:    false,
+				},
+			},
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:        "previous",
+					Destination: &previous,
+					Usage:       "previous (Returns the previous version of a launch configuration)",
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				version, err := aws.GetLaunchConfigurationVersion(c.NamedArg("class"), previous)
+				if err != nil {
+					return err
+				}
+
+				fmt.Println(version)
 
-				fmt.Println(version)
-
 				return nil
 			},
 		},
@@ -1447,6 +2018,57 @@ func main() {
 				return nil
 			},
 		},
+		{
+			Name:  "getMetrics",
+			Usage: "Get CloudWatch metrics for Instances, AutoScaling Groups, EBS Volumes or Load Balancers",
+			Arguments: []cli.Argument{
+				{
+					Name:        "search",
+					Description: "The assets to get metrics for",
+					Optional:    false,
+				},
+			},
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "type",
+					Destination: &assetType,
+					Usage:       "type (Limit the search to instances, autoscalegroups, volumes or loadbalancers)",
+				},
+				cli.StringFlag{
+					Name:        "metric",
+					Destination: &metric,
+					Usage:       "metric (CloudWatch metric name, defaults to CPUUtilization, VolumeQueueLength or RequestCount)",
+				},
+				cli.StringFlag{
+					Name:        "statistic",
+					Value:       "Average",
+					Destination: &statistic,
+					Usage:       "statistic (Average, Sum, Minimum, Maximum or SampleCount)",
+				},
+				cli.IntFlag{
+					Name:        "period",
+					Value:       300,
+					Destination: &period,
+					Usage:       "period (Seconds per datapoint, a multiple of 60)",
+				},
+				cli.StringFlag{
+					Name:        "since",
+					Value:       "3h",
+					Destination: &since,
+					Usage:       "since (How far back to look, ie: 45m or 24h)",
+				},
+				cli.StringFlag{
+					Name:        "output",
+					Value:       "table",
+					Destination: &output,
+					Usage:       "output (table, sparkline or json)",
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				return aws.PrintMetrics(c.NamedArg("search"), assetType, metric, statistic, period, since, output)
+			},
+		},
 		{
 			Name:  "stopInstances",
 			Usage: "Stop instances",
@@ -1693,6 +2315,27 @@ func main() {
 				return nil
 			},
 		},
+		{
+			Name:  "listCertificates",
+			Usage: "List ACM Certificates",
+			Arguments: []cli.Argument{
+				{
+					Name:        "search",
+					Description: "The keyword to search for",
+					Optional:    true,
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				certificates, errs := aws.GetCertificates(c.NamedArg("search"))
+				if errs != nil {
+					return cli.NewExitError("Error Listing Certificates!", 1)
+				}
+				certificates.PrintTable()
+
+				return nil
+			},
+		},
 		{
 			Name:  "listCommandInvocations",
 			Usage: "List SSM Command Invocations",
@@ -1725,6 +2368,27 @@ func main() {
 				return nil
 			},
 		},
+		{
+			Name:  "listHealthChecks",
+			Usage: "List Route53 Health Checks",
+			Arguments: []cli.Argument{
+				{
+					Name:        "search",
+					Description: "The keyword to search for",
+					Optional:    true,
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				healthChecks, err := aws.GetHealthChecks(c.NamedArg("search"))
+				if err != nil {
+					return cli.NewExitError("Error Listing Health Checks!", 1)
+				}
+				healthChecks.PrintTable()
+
+				return nil
+			},
+		},
 		{
 			Name:  "listHostedZones",
 			Usage: "List Route53 Hosted Zones",
@@ -1957,6 +2621,69 @@ func main() {
 				return nil
 			},
 		},
+		{
+			Name:  "listLogGroups",
+			Usage: "List CloudWatch Log Groups",
+			Arguments: []cli.Argument{
+				{
+					Name:        "search",
+					Description: "The keyword to search for",
+					Optional:    true,
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				groups, errs := aws.GetLogGroups(c.NamedArg("search"))
+				if errs != nil {
+					return cli.NewExitError("Error Listing Log Groups!", 1)
+				}
+				groups.PrintTable()
+
+				return nil
+			},
+		},
+		{
+			Name:  "listNetworkAcls",
+			Usage: "List VPC Network ACLs",
+			Arguments: []cli.Argument{
+				{
+					Name:        "search",
+					Description: "The keyword to search for",
+					Optional:    true,
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				networkAcls, errs := aws.GetNetworkAcls(c.NamedArg("search"))
+				if errs != nil {
+					return cli.NewExitError("Error Listing Network ACLs!", 1)
+				}
+				networkAcls.PrintTable()
+
+				return nil
+			},
+		},
+		{
+			Name:  "listParameters",
+			Usage: "List SSM Parameters",
+			Arguments: []cli.Argument{
+				{
+					Name:        "search",
+					Description: "The keyword to search for",
+					Optional:    true,
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				params, errs := aws.GetParameters(c.NamedArg("search"))
+				if errs != nil {
+					return cli.NewExitError("Error Listing Parameters!", 1)
+				}
+				params.PrintTable()
+
+				return nil
+			},
+		},
 		{
 			Name:  "listResourceRecords",
 			Usage: "List Route53 Resource Records",
@@ -2174,6 +2901,27 @@ func main() {
 				return nil
 			},
 		},
+		{
+			Name:  "listVpcEndpoints",
+			Usage: "List VPC Endpoints",
+			Arguments: []cli.Argument{
+				{
+					Name:        "search",
+					Description: "The keyword to search for",
+					Optional:    true,
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				endpoints, errs := aws.GetVpcEndpoints(c.NamedArg("search"))
+				if errs != nil {
+					return cli.NewExitError("Error Listing VPC Endpoints!", 1)
+				}
+				endpoints.PrintTable()
+
+				return nil
+			},
+		},
 		{
 			Name:  "listVpcs",
 			Usage: "List Vpcs",
@@ -2195,6 +2943,100 @@ func main() {
 				return nil
 			},
 		},
+		{
+			Name:  "modifyVolume",
+			Usage: "Modify the size, type or IOPS of an EBS Volume",
+			Arguments: []cli.Argument{
+				{
+					Name:        "search",
+					Description: "The volume to modify",
+					Optional:    false,
+				},
+			},
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:        "size",
+					Destination: &size,
+					Usage:       "size (The new size in GB)",
+				},
+				cli.StringFlag{
+					Name:        "type",
+					Destination: &volumeType,
+					Usage:       "type (The new volume type)",
+				},
+				cli.IntFlag{
+					Name:        "iops",
+					Destination: &iops,
+					Usage:       "iops (The new provisioned IOPS)",
+				},
+				cli.BoolFlag{
+					Name:        "grow",
+					Destination: &grow,
+					Usage:       "grow (Run the Volume Class Grow Command on the attached instance)",
+				},
+				cli.BoolFlag{
+					Name:        "update-class",
+					Destination: &updateClass,
+					Usage:       "update-class (Save the new size, type and IOPS to the Volume Class)",
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				err := aws.ModifyVolume(c.NamedArg("search"), size, volumeType, iops, grow, updateClass, dryRun)
+				if err != nil {
+					return err
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "putParameter",
+			Usage: "Create or update an SSM Parameter",
+			Arguments: []cli.Argument{
+				{
+					Name:        "name",
+					Description: "The name of the parameter (ie: /app/db/password)",
+					Optional:    false,
+				},
+				{
+					Name:        "value",
+					Description: "The value of the parameter, or - to read it from stdin",
+					Optional:    false,
+				},
+				{
+					Name:        "region",
+					Description: "The region to put the parameter in",
+					Optional:    false,
+				},
+			},
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "type",
+					Value:       "SecureString",
+					Destination: &parameterType,
+					Usage:       "type (String, StringList or SecureString)",
+				},
+				cli.StringFlag{
+					Name:        "kms-key",
+					Destination: &kmsKeyId,
+					Usage:       "kms-key (KMS Key ID to encrypt a SecureString with, defaults to the account default key)",
+				},
+				cli.StringFlag{
+					Name:        "description",
+					Destination: &description,
+					Usage:       "description (Description of the parameter)",
+				},
+				cli.BoolFlag{
+					Name:        "overwrite",
+					Destination: &overwrite,
+					Usage:       "overwrite (Update the parameter if it already exists)",
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				return aws.PutParameter(c.NamedArg("name"), c.NamedArg("value"), c.NamedArg("region"), parameterType, kmsKeyId, description, overwrite, dryRun)
+			},
+		},
 		{
 			Name:  "resumeProcesses",
 			Usage: "Resume scaling processes on Autoscaling Groups",
@@ -2219,6 +3061,21 @@ func main() {
 				return err
 			},
 		},
+		{
+			Name:  "rotateKeyPair",
+			Usage: "Rotate the key of a Key Pair class in every region it exists in",
+			Arguments: []cli.Argument{
+				{
+					Name:        "class",
+					Description: "The class of the key pair to rotate",
+					Optional:    false,
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				return aws.RotateKeyPair(c.NamedArg("class"), dryRun)
+			},
+		},
 		{
 			Name:  "runCommand",
 			Usage: "Run a command on a set of EC2 Instances",
@@ -2230,13 +3087,29 @@ func main() {
 				},
 				{
 					Name:        "command",
-					Description: "The command to run on the instances",
-					Optional:    false,
+					Description: "The command to run on the instances (optional when using a class or document)",
+					Optional:    true,
+				},
+			},
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "class",
+					Destination: &commandClass,
+					Usage:       "class (Command class to run)",
+				},
+				cli.StringFlag{
+					Name:        "document",
+					Destination: &document,
+					Usage:       "document (SSM Document to run, defaults to AWS-RunShellScript)",
+				},
+				cli.StringSliceFlag{
+					Name:  "parameter",
+					Usage: "parameter (Document parameter as key=value, can be repeated)",
 				},
 			},
 			Before: setupCheck,
 			Action: func(c *cli.Context) error {
-				cmdInvocations, err := aws.RunCommand(c.NamedArg("search"), c.NamedArg("command"), dryRun)
+				cmdInvocations, err := aws.RunCommand(c.NamedArg("search"), c.NamedArg("command"), commandClass, document, c.StringSlice("parameter"), dryRun)
 				if err != nil {
 					return err
 				} else {
@@ -2245,6 +3118,96 @@ func main() {
 				return err
 			},
 		},
+		{
+			Name:  "searchLogs",
+			Usage: "Search CloudWatch Log Groups for matching events",
+			Arguments: []cli.Argument{
+				{
+					Name:        "filter",
+					Description: "The CloudWatch Logs filter pattern to search for",
+					Optional:    false,
+				},
+				{
+					Name:        "group",
+					Description: "The log groups to search (optional, defaults to all log groups)",
+					Optional:    true,
+				},
+			},
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "since",
+					Value:       "1h",
+					Destination: &since,
+					Usage:       "since (How far back to search, ie: 45m or 24h)",
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				return aws.SearchLogs(c.NamedArg("filter"), c.NamedArg("group"), since)
+			},
+		},
+		{
+			Name:  "shareImage",
+			Usage: "Share a Machine Image with other AWS Accounts",
+			Arguments: []cli.Argument{
+				{
+					Name:        "search",
+					Description: "The image to share",
+					Optional:    false,
+				},
+				{
+					Name:        "accounts",
+					Description: "Comma separated list of AWS Account IDs",
+					Optional:    false,
+				},
+			},
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:        "revoke",
+					Destination: &revoke,
+					Usage:       "revoke (Revoke launch permissions instead of granting them)",
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				err := aws.ShareImage(c.NamedArg("search"), c.NamedArg("accounts"), revoke, dryRun)
+				if err != nil {
+					return err
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "shareSnapshot",
+			Usage: "Share an EBS Snapshot with other AWS Accounts",
+			Arguments: []cli.Argument{
+				{
+					Name:        "search",
+					Description: "The snapshot to share",
+					Optional:    false,
+				},
+				{
+					Name:        "accounts",
+					Description: "Comma separated list of AWS Account IDs",
+					Optional:    false,
+				},
+			},
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:        "revoke",
+					Destination: &revoke,
+					Usage:       "revoke (Revoke createVolume permissions instead of granting them)",
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				err := aws.ShareSnapshot(c.NamedArg("search"), c.NamedArg("accounts"), revoke, dryRun)
+				if err != nil {
+					return err
+				}
+				return nil
+			},
+		},
 		{
 			Name:  "suspendProcesses",
 			Usage: "Suspend scaling processes on Autoscaling Groups",
@@ -2269,6 +3232,77 @@ func main() {
 				return nil
 			},
 		},
+		{
+			Name:  "tailLogs",
+			Usage: "Print the latest events of a CloudWatch Log Group",
+			Arguments: []cli.Argument{
+				{
+					Name:        "group",
+					Description: "The log group to tail",
+					Optional:    false,
+				},
+			},
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "stream",
+					Destination: &stream,
+					Usage:       "stream (Only show events from log streams with this prefix)",
+				},
+				cli.StringFlag{
+					Name:        "filter",
+					Destination: &filter,
+					Usage:       "filter (CloudWatch Logs filter pattern)",
+				},
+				cli.StringFlag{
+					Name:        "since",
+					Value:       "10m",
+					Destination: &since,
+					Usage:       "since (How far back to start, ie: 10m or 2h)",
+				},
+				cli.BoolFlag{
+					Name:        "follow",
+					Destination: &follow,
+					Usage:       "follow (Keep printing new events as they arrive)",
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				return aws.TailLogs(c.NamedArg("group"), stream, filter, since, follow)
+			},
+		},
+		{
+			Name:  "teardown",
+			Usage: "Delete VPCs along with every asset inside of them, in reverse dependency order",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "vpc",
+					Destination: &vpc,
+					Usage:       "vpc (The search term for the VPC(s) to tear down)",
+				},
+				cli.StringFlag{
+					Name:        "class",
+					Destination: &class,
+					Usage:       "class (Tear down the VPC(s) of this class instead)",
+				},
+				cli.StringFlag{
+					Name:        "region",
+					Destination: &region,
+					Usage:       "region (Limit the teardown to a single region)",
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				if (vpc == "") == (class == "") {
+					return cli.NewExitError("Either a --vpc or a --class is required!", 1)
+				}
+
+				err := aws.Teardown(vpc, class, region, dryRun)
+				if err != nil {
+					return err
+				}
+				return nil
+			},
+		},
 		{
 			Name:  "updateAutoScaleGroups",
 			Usage: "Update AutoScaling Groups",
@@ -2295,10 +3329,15 @@ func main() {
 					Destination: &force,
 					Usage:       "force-yes (Default to 'yes' on prompts)",
 				},
+				cli.StringFlag{
+					Name:        "plan-out",
+					Destination: &planOut,
+					Usage:       "plan-out (Write the changes to a plan file to be approved and applied later)",
+				},
 			},
 			Before: setupCheck,
 			Action: func(c *cli.Context) error {
-				err := aws.UpdateAutoScaleGroups(c.NamedArg("search"), c.NamedArg("version"), double, force, dryRun)
+				err := aws.UpdateAutoScaleGroups(c.NamedArg("search"), c.NamedArg("version"), planOut, double, force, dryRun)
 				if err != nil {
 					return err
 				}
@@ -2320,9 +3359,40 @@ func main() {
 					Optional:    true,
 				},
 			},
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "plan-out",
+					Destination: &planOut,
+					Usage:       "plan-out (Write the changes to a plan file to be approved and applied later)",
+				},
+			},
 			Before: setupCheck,
 			Action: func(c *cli.Context) error {
-				err := aws.UpdateLoadBalancers(c.NamedArg("search"), c.NamedArg("region"), dryRun)
+				err := aws.UpdateLoadBalancers(c.NamedArg("search"), c.NamedArg("region"), planOut, dryRun)
+				if err != nil {
+					return err
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "updateNetworkAcls",
+			Usage: "Update VPC Network ACLs",
+			Arguments: []cli.Argument{
+				{
+					Name:        "search",
+					Description: "The search term of the network acls to update",
+					Optional:    false,
+				},
+				{
+					Name:        "region",
+					Description: "The region to update the network acls in (optional)",
+					Optional:    true,
+				},
+			},
+			Before: setupCheck,
+			Action: func(c *cli.Context) error {
+				err := aws.UpdateNetworkAcls(c.NamedArg("search"), c.NamedArg("region"), dryRun)
 				if err != nil {
 					return err
 				}
@@ -2368,9 +3438,16 @@ func main() {
 					Optional:    true,
 				},
 			},
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "plan-out",
+					Destination: &planOut,
+					Usage:       "plan-out (Write the changes to a plan file to be approved and applied later)",
+				},
+			},
 			Before: setupCheck,
 			Action: func(c *cli.Context) error {
-				err := aws.UpdateSecurityGroups(c.NamedArg("search"), c.NamedArg("region"), dryRun)
+				err := aws.UpdateSecurityGroups(c.NamedArg("search"), c.NamedArg("region"), planOut, dryRun)
 				if err != nil {
 					return err
 				}
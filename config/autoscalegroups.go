@@ -25,6 +25,12 @@ type AutoscaleGroupClass struct {
 	TerminationPolicies      []string `json:"terminationPolicies" awsmClass:"Termination Policies"`
 	LoadBalancerNames        []string `json:"loadBalancerNames" awsmClass:"Load Balancer Names"`
 	Alarms                   []string `json:"alarms" awsmClass:"Alarms"`
+
+	// Mixed Instances Policy, used when any instance type overrides are set
+	InstanceTypeOverrides       []string `json:"instanceTypeOverrides" awsmClass:"Instance Type Overrides"`
+	OnDemandBaseCapacity        int      `json:"onDemandBaseCapacity" awsmClass:"On-Demand Base Capacity"`
+	OnDemandPercentageAboveBase int      `json:"onDemandPercentageAboveBase" awsmClass:"On-Demand Percentage Above Base"`
+	SpotAllocationStrategy      string   `json:"spotAllocationStrategy" awsmClass:"Spot Allocation Strategy"` // lowest-price / capacity-optimized
 }
 
 // DefaultAutoscaleGroupClasses returns the default Autoscale Group Classes
@@ -49,6 +55,16 @@ func DefaultAutoscaleGroupClasses() AutoscaleGroupClasses {
 	return defaultASGs
 }
 
+// OnDemandPercentage returns the On-Demand Percentage Above Base of a Mixed Instances Policy. An unset percentage is all
+// on-demand, a percentage of 0 only means all spot when a spot allocation strategy is set as well
+func (a AutoscaleGroupClass) OnDemandPercentage() int {
+	if a.OnDemandPercentageAboveBase == 0 && a.SpotAllocationStrategy == "" {
+		return 100
+	}
+
+	return a.OnDemandPercentageAboveBase
+}
+
 // SaveAlarmClass reads unmarshals a byte slice and inserts it into the db
 func SaveAutoscalingGroupClass(className string, data []byte) (class AutoscaleGroupClass, err error) {
 	err = json.Unmarshal(data, &class)
@@ -131,6 +147,18 @@ func (c AutoscaleGroupClasses) Marshal(items []*simpledb.Item) {
 			case "Alarms":
 				cfg.Alarms = append(cfg.Alarms, val)
 
+			case "InstanceTypeOverrides":
+				cfg.InstanceTypeOverrides = append(cfg.InstanceTypeOverrides, val)
+
+			case "OnDemandBaseCapacity":
+				cfg.OnDemandBaseCapacity, _ = strconv.Atoi(val)
+
+			case "OnDemandPercentageAboveBase":
+				cfg.OnDemandPercentageAboveBase, _ = strconv.Atoi(val)
+
+			case "SpotAllocationStrategy":
+				cfg.SpotAllocationStrategy = val
+
 			}
 		}
 		c[name] = *cfg
@@ -59,6 +59,21 @@ func Insert(classType string, classInterface interface{}) error {
 			itemsMap[itemName] = append(itemsMap[itemName], BuildAttributes(config, classType)...)
 		}
 
+	case "networkacls":
+		for class, config := range classInterface.(NetworkAclClasses) {
+			itemName = classType + "/" + class
+			itemsMap[itemName] = append(itemsMap[itemName], BuildAttributes(config, classType)...)
+
+			// Delete the existing entries
+			DeleteItemsByType(classType + "/" + class + "/entries")
+
+			// Network ACL Entries
+			for _, entry := range config.NetworkAclEntries {
+				itemName = classType + "/" + class + "/entries/" + uuid.Must(uuid.NewV4()).String()
+				itemsMap[itemName] = append(itemsMap[itemName], BuildAttributes(entry, classType+"/"+class+"/entries")...)
+			}
+		}
+
 	case "instances":
 		for class, config := range classInterface.(InstanceClasses) {
 			itemName = classType + "/" + class
@@ -143,12 +158,24 @@ func Insert(classType string, classInterface interface{}) error {
 			itemsMap[itemName] = append(itemsMap[itemName], BuildAttributes(config, classType)...)
 		}
 
+	case "commands":
+		for class, config := range classInterface.(CommandClasses) {
+			itemName = classType + "/" + class
+			itemsMap[itemName] = append(itemsMap[itemName], BuildAttributes(config, classType)...)
+		}
+
 	case "widgets":
 		for widget, config := range classInterface.(Widgets) {
 			itemName = classType + "/" + widget
 			itemsMap[itemName] = append(itemsMap[itemName], BuildAttributes(config, classType)...)
 		}
 
+	case "scheduleruns":
+		for schedule, run := range classInterface.(ScheduleRuns) {
+			itemName = classType + "/" + schedule
+			itemsMap[itemName] = append(itemsMap[itemName], BuildAttributes(run, classType)...)
+		}
+
 	default:
 		return errors.New("Insert does not have switch for [" + classType + "]! No configurations of this type are being installed!")
 
@@ -191,6 +218,7 @@ func Export() (export map[string]interface{}, err error) {
 	export = make(map[string]interface{})
 	export["vpcs"], _ = LoadAllVpcClasses()
 	export["subnets"], _ = LoadAllSubnetClasses()
+	export["networkacls"], _ = LoadAllNetworkAclClasses()
 	export["instances"], _ = LoadAllInstanceClasses()
 	export["volumes"], _ = LoadAllVolumeClasses()
 	export["snapshots"], _ = LoadAllSnapshotClasses()
@@ -202,6 +230,7 @@ func Export() (export map[string]interface{}, err error) {
 	export["alarms"], _ = LoadAllAlarmClasses()
 	export["securitygroups"], _ = LoadAllSecurityGroupClasses()
 	export["keypairs"], _ = LoadAllKeyPairClasses()
+	export["commands"], _ = LoadAllCommandClasses()
 	export["widgets"], _ = LoadAllWidgets()
 
 	return
@@ -218,6 +247,9 @@ func LoadAllClasses(classType string) (configs interface{}, err error) {
 	case "subnets":
 		return LoadAllSubnetClasses()
 
+	case "networkacls":
+		return LoadAllNetworkAclClasses()
+
 	case "instances":
 		return LoadAllInstanceClasses()
 
@@ -251,6 +283,9 @@ func LoadAllClasses(classType string) (configs interface{}, err error) {
 	case "keypairs":
 		return LoadAllKeyPairClasses()
 
+	case "commands":
+		return LoadAllCommandClasses()
+
 		/*
 			case "addresses":
 				return LoadAllAddresses()
@@ -275,6 +310,9 @@ func LoadClassByName(classType, className string) (configs interface{}, err erro
 	case "subnets":
 		return LoadSubnetClass(className)
 
+	case "networkacls":
+		return LoadNetworkAclClass(className)
+
 	case "instances":
 		return LoadInstanceClass(className)
 
@@ -308,6 +346,9 @@ func LoadClassByName(classType, className string) (configs interface{}, err erro
 	case "keypairs":
 		return LoadKeyPairClass(className)
 
+	case "commands":
+		return LoadCommandClass(className)
+
 	default:
 		err = errors.New("LoadClassByName does not have switch for [" + classType + "]! No class configuration of this type is being loaded!")
 
@@ -330,6 +371,9 @@ func LoadAllClassOptions(classType string) (options map[string]interface{}, err
 	case "vpcs":
 
 	case "subnets":
+		classOptionKeys = []string{"networkacls"}
+
+	case "networkacls":
 
 	case "instances":
 		classOptionKeys = []string{"securitygroups", "volumes", "vpcs", "subnets", "images", "keypairs", "iamusers"}
@@ -362,6 +406,8 @@ func LoadAllClassOptions(classType string) (options map[string]interface{}, err
 
 	case "keypairs":
 
+	case "commands":
+
 	default:
 		err = errors.New("LoadAllClassOptions does not have switch for [" + classType + "]! No options of this type are being loaded!")
 	}
@@ -0,0 +1,109 @@
+package config
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/simpledb"
+)
+
+// CommandClasses is a map of Command Classes
+type CommandClasses map[string]CommandClass
+
+// CommandClass is a single Command Class, a saved SSM command. Scripts are limited to 1024 bytes by SimpleDB, longer ones belong in an SSM Document.
+type CommandClass struct {
+	Document          string   `json:"document" awsmClass:"Document"`
+	Script            string   `json:"script" awsmClass:"Script"`
+	Parameters        []string `json:"parameters" awsmClass:"Parameters"` // key=value
+	TimeoutSeconds    int      `json:"timeoutSeconds" awsmClass:"Timeout Seconds"`
+	WorkingDirectory  string   `json:"workingDirectory" awsmClass:"Working Directory"`
+	OutputS3Bucket    string   `json:"outputS3Bucket" awsmClass:"Output S3 Bucket"`
+	OutputS3KeyPrefix string   `json:"outputS3KeyPrefix" awsmClass:"Output S3 Key Prefix"`
+}
+
+// DefaultCommandClasses returns the default Command Classes
+func DefaultCommandClasses() CommandClasses {
+	defaultCommands := make(CommandClasses)
+
+	defaultCommands["uptime"] = CommandClass{
+		Document:       "AWS-RunShellScript",
+		Script:         "uptime",
+		TimeoutSeconds: 60,
+	}
+
+	return defaultCommands
+}
+
+// SaveCommandClass reads unmarshals a byte slice and inserts it into the db
+func SaveCommandClass(className string, data []byte) (class CommandClass, err error) {
+	err = json.Unmarshal(data, &class)
+	if err != nil {
+		return
+	}
+
+	err = Insert("commands", CommandClasses{className: class})
+	return
+}
+
+// LoadCommandClass loads a Command Class by its name
+func LoadCommandClass(name string) (CommandClass, error) {
+	cfgs := make(CommandClasses)
+	item, err := GetItemByName("commands", name)
+	if err != nil {
+		return cfgs[name], err
+	}
+
+	cfgs.Marshal([]*simpledb.Item{item})
+	return cfgs[name], nil
+}
+
+// LoadAllCommandClasses loads all Command Classes
+func LoadAllCommandClasses() (CommandClasses, error) {
+	cfgs := make(CommandClasses)
+	items, err := GetItemsByType("commands")
+	if err != nil {
+		return cfgs, err
+	}
+
+	cfgs.Marshal(items)
+	return cfgs, nil
+}
+
+// Marshal puts items from SimpleDB into a Command Class
+func (c CommandClasses) Marshal(items []*simpledb.Item) {
+	for _, item := range items {
+		name := strings.Replace(*item.Name, "commands/", "", -1)
+		cfg := new(CommandClass)
+		for _, attribute := range item.Attributes {
+
+			val := *attribute.Value
+
+			switch *attribute.Name {
+
+			case "Document":
+				cfg.Document = val
+
+			case "Script":
+				cfg.Script = val
+
+			case "Parameters":
+				cfg.Parameters = append(cfg.Parameters, val)
+
+			case "TimeoutSeconds":
+				cfg.TimeoutSeconds, _ = strconv.Atoi(val)
+
+			case "WorkingDirectory":
+				cfg.WorkingDirectory = val
+
+			case "OutputS3Bucket":
+				cfg.OutputS3Bucket = val
+
+			case "OutputS3KeyPrefix":
+				cfg.OutputS3KeyPrefix = val
+
+			}
+		}
+		c[name] = *cfg
+	}
+}
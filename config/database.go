@@ -138,6 +138,7 @@ func CreateAwsmDatabase() error {
 	Insert("securitygroups", DefaultSecurityGroupClasses())
 	Insert("vpcs", DefaultVpcClasses())
 	Insert("subnets", DefaultSubnetClasses())
+	Insert("networkacls", DefaultNetworkAclClasses())
 	Insert("instances", DefaultInstanceClasses())
 	Insert("alarms", DefaultAlarms())
 	Insert("images", DefaultImageClasses())
@@ -148,6 +149,7 @@ func CreateAwsmDatabase() error {
 	Insert("snapshots", DefaultSnapshotClasses())
 	Insert("autoscalegroups", DefaultAutoscaleGroupClasses())
 	Insert("keypairs", DefaultKeyPairClasses())
+	Insert("commands", DefaultCommandClasses())
 	Insert("widgets", DefaultWidgets())
 
 	return nil
@@ -200,6 +202,16 @@ func BuildAttributes(class interface{}, classType string) []*simpledb.Replaceabl
 				})
 			}
 
+		case map[string]string:
+			// Stored as one "key=value" attribute per entry
+			for key, value := range val.Field(i).Interface().(map[string]string) {
+				attributes = append(attributes, &simpledb.ReplaceableAttribute{
+					Name:    aws.String(name),
+					Value:   aws.String(key + "=" + value),
+					Replace: aws.Bool(true),
+				})
+			}
+
 		case bool:
 			attributes = append(attributes, &simpledb.ReplaceableAttribute{
 				Name:    aws.String(name),
@@ -215,7 +227,7 @@ func BuildAttributes(class interface{}, classType string) []*simpledb.Replaceabl
 				Replace: aws.Bool(true),
 			})
 
-		case []SecurityGroupGrant, []LoadBalancerListener:
+		case []SecurityGroupGrant, []LoadBalancerListener, []NetworkAclEntry:
 			// Handled in config/classes.go, for now
 
 		case LoadBalancerHealthCheck:
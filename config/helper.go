@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 )
 
@@ -42,6 +43,17 @@ func ExtractAwsmClass(in interface{}) (keys, values []string) {
 					}
 				}
 
+			case "map[string]string":
+				entries := inValue.Field(k).Interface().(map[string]string)
+				mapKeys := make([]string, 0, len(entries))
+				for key := range entries {
+					mapKeys = append(mapKeys, key)
+				}
+				sort.Strings(mapKeys)
+				for _, key := range mapKeys {
+					sVal += fmt.Sprintf("%s: %s\n\n", key, entries[key])
+				}
+
 			case "[]config.SecurityGroupGrant":
 				grants := inValue.Field(k).Interface().([]SecurityGroupGrant)
 				for _, grant := range grants {
@@ -74,7 +86,23 @@ func ExtractAwsmClass(in interface{}) (keys, values []string) {
 			case "[]config.LoadBalancerListener":
 				listeners := inValue.Field(k).Interface().([]LoadBalancerListener)
 				for _, listener := range listeners {
-					sVal += fmt.Sprintf("%s:%d>%s:%d\n\n", listener.Protocol, listener.LoadBalancerPort, listener.InstanceProtocol, listener.InstancePort)
+					sVal += fmt.Sprintf("%s:%d>%s:%d", listener.Protocol, listener.LoadBalancerPort, listener.InstanceProtocol, listener.InstancePort)
+					if listener.SSLCertificateDomain != "" {
+						sVal += " (" + listener.SSLCertificateDomain + ")"
+					}
+					sVal += "\n\n"
+				}
+
+			case "[]config.NetworkAclEntry":
+				entries := inValue.Field(k).Interface().([]NetworkAclEntry)
+				for _, entry := range entries {
+
+					direction := ">"
+					if entry.Type == "egress" {
+						direction = "<"
+					}
+
+					sVal += fmt.Sprintf("%d %s %s:%d-%d%s%s\n\n", entry.RuleNumber, entry.RuleAction, entry.Protocol, entry.FromPort, entry.ToPort, direction, entry.CidrBlock)
 				}
 
 			default:
@@ -140,7 +168,11 @@ func ExtractAwsmWidget(in interface{}) (keys, values []string) {
 			case "[]config.LoadBalancerListener":
 				listeners := inValue.Field(k).Interface().([]LoadBalancerListener)
 				for _, listener := range listeners {
-					sVal += fmt.Sprintf("%s:%d>%s:%d\n\n", listener.Protocol, listener.LoadBalancerPort, listener.InstanceProtocol, listener.InstancePort)
+					sVal += fmt.Sprintf("%s:%d>%s:%d", listener.Protocol, listener.LoadBalancerPort, listener.InstanceProtocol, listener.InstancePort)
+					if listener.SSLCertificateDomain != "" {
+						sVal += " (" + listener.SSLCertificateDomain + ")"
+					}
+					sVal += "\n\n"
 				}
 
 			default:
@@ -13,12 +13,16 @@ type ImageClasses map[string]ImageClass
 
 // ImageClass is a single Image class
 type ImageClass struct {
-	Instance         string   `json:"instance" awsmClass:"Instance"`
-	Rotate           bool     `json:"rotate" awsmClass:"Rotate"`
-	Retain           int      `json:"retain" awsmClass:"Retain"`
-	Propagate        bool     `json:"propagate" awsmClass:"Propagate"`
-	PropagateRegions []string `json:"propagateRegions" awsmClass:"Propagate Regions"`
-	Version          int      `json:"version" awsmClass:"Version"`
+	Instance         string            `json:"instance" awsmClass:"Instance"`
+	Rotate           bool              `json:"rotate" awsmClass:"Rotate"`
+	Retain           int               `json:"retain" awsmClass:"Retain"`
+	Propagate        bool              `json:"propagate" awsmClass:"Propagate"`
+	PropagateRegions []string          `json:"propagateRegions" awsmClass:"Propagate Regions"`
+	Version          int               `json:"version" awsmClass:"Version"`
+	Schedule         string            `json:"schedule" awsmClass:"Schedule"`
+	ShareAccounts    []string          `json:"shareAccounts" awsmClass:"Share Accounts"`
+	Encrypt          bool              `json:"encrypt" awsmClass:"Encrypt"`
+	KmsKeyIds        map[string]string `json:"kmsKeyIds" awsmClass:"KMS Key IDs"`
 }
 
 // DefaultImageClasses returns the default Image classes
@@ -101,6 +105,23 @@ func (c ImageClasses) Marshal(items []*simpledb.Item) {
 			case "Instance":
 				cfg.Instance = val
 
+			case "Schedule":
+				cfg.Schedule = val
+
+			case "ShareAccounts":
+				cfg.ShareAccounts = append(cfg.ShareAccounts, val)
+
+			case "Encrypt":
+				cfg.Encrypt, _ = strconv.ParseBool(val)
+
+			case "KmsKeyIds":
+				if cfg.KmsKeyIds == nil {
+					cfg.KmsKeyIds = make(map[string]string)
+				}
+				if parts := strings.SplitN(val, "=", 2); len(parts) == 2 {
+					cfg.KmsKeyIds[parts[0]] = parts[1]
+				}
+
 			}
 		}
 		c[name] = *cfg
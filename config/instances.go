@@ -26,6 +26,12 @@ type InstanceClass struct {
 	ShutdownBehavior   string   `json:"shutdownBehavior" awsmClass:"Shutdown Behaviour"`
 	IAMInstanceProfile string   `json:"iamInstanceProfile" awsmClass:"IAM Instance Profile"`
 	UserData           string   `json:"userData"`
+
+	// Spot
+	MarketType               string `json:"marketType" awsmClass:"Market Type"`                              // on-demand / spot
+	SpotMaxPrice             string `json:"spotMaxPrice" awsmClass:"Spot Max Price"`                         // empty for the on-demand price
+	SpotInterruptionBehavior string `json:"spotInterruptionBehavior" awsmClass:"Spot Interruption Behavior"` // terminate / stop / hibernate
+	SpotRequestType          string `json:"spotRequestType" awsmClass:"Spot Request Type"`                   // one-time / persistent
 }
 
 // DefaultInstanceClasses returns the default Instance classes
@@ -131,6 +137,18 @@ func (c InstanceClasses) Marshal(items []*simpledb.Item) {
 			case "IAMInstanceProfile":
 				cfg.IAMInstanceProfile = val
 
+			case "MarketType":
+				cfg.MarketType = val
+
+			case "SpotMaxPrice":
+				cfg.SpotMaxPrice = val
+
+			case "SpotInterruptionBehavior":
+				cfg.SpotInterruptionBehavior = val
+
+			case "SpotRequestType":
+				cfg.SpotRequestType = val
+
 			}
 		}
 		c[name] = *cfg
@@ -1,10 +1,17 @@
 package config
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/service/simpledb"
+	"golang.org/x/crypto/ssh"
 )
 
 // KeyPairClasses is a map of Image classes
@@ -19,6 +26,7 @@ type KeyPairClass struct {
 	PrivateKey3 string `json:"-"`
 	PrivateKey4 string `json:"-"`
 	PrivateKey  string `json:"privateKey" awsm:"ignore"`
+	Version     int    `json:"version" awsmClass:"Version"`
 }
 
 // DefaultKeyPairClasses returns the default KeyPair classes
@@ -125,8 +133,57 @@ func (c KeyPairClasses) Marshal(items []*simpledb.Item) {
 			case "PrivateKey4":
 				cfg.PrivateKey4 = val
 
+			case "Version":
+				cfg.Version, _ = strconv.Atoi(val)
+
 			}
 		}
 		c[name] = *cfg
 	}
 }
+
+// KeyName returns the name the current version of a KeyPair class is imported into AWS as, rotated keys are versioned
+func (c KeyPairClass) KeyName(name string) string {
+	if c.Version == 0 {
+		return name
+	}
+	return fmt.Sprintf("%s-v%d", name, c.Version)
+}
+
+// SetKeys updates the public and private keys and the version of a KeyPair
+func (c *KeyPairClass) SetKeys(name, publicKey, privateKey string, version int) error {
+	privateKeyLen := len(privateKey) / 4
+
+	c.PublicKey = publicKey
+	c.PrivateKey1 = privateKey[:privateKeyLen]
+	c.PrivateKey2 = privateKey[privateKeyLen : privateKeyLen*2]
+	c.PrivateKey3 = privateKey[privateKeyLen*2 : privateKeyLen*3]
+	c.PrivateKey4 = privateKey[privateKeyLen*3:]
+	c.Version = version
+
+	updateCfgs := make(KeyPairClasses)
+	updateCfgs[name] = *c
+
+	return Insert("keypairs", updateCfgs)
+}
+
+// GenerateKeyPair generates a new RSA key, returning the public key in OpenSSH authorized_keys format and the PEM encoded private key
+func GenerateKeyPair() (publicKey, privateKey string, err error) {
+	key, err := rsa.GenerateKey(rand.Reader, 4096)
+	if err != nil {
+		return
+	}
+
+	sshPublicKey, err := ssh.NewPublicKey(&key.PublicKey)
+	if err != nil {
+		return
+	}
+
+	publicKey = string(ssh.MarshalAuthorizedKey(sshPublicKey))
+	privateKey = string(pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	}))
+
+	return
+}
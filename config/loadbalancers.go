@@ -31,12 +31,13 @@ type LoadBalancerClass struct {
 
 // LoadBalancerListener is a single Load Balancer Listener
 type LoadBalancerListener struct {
-	ID               string `json:"id" hash:"ignore" awsm:"ignore"` // Needed?
-	InstancePort     int    `json:"instancePort"`
-	LoadBalancerPort int    `json:"loadBalancerPort"`
-	Protocol         string `json:"protocol"`
-	InstanceProtocol string `json:"instanceProtocol"`
-	SSLCertificateID string `json:"sslCertificateID"`
+	ID                   string `json:"id" hash:"ignore" awsm:"ignore"` // Needed?
+	InstancePort         int    `json:"instancePort"`
+	LoadBalancerPort     int    `json:"loadBalancerPort"`
+	Protocol             string `json:"protocol"`
+	InstanceProtocol     string `json:"instanceProtocol"`
+	SSLCertificateID     string `json:"sslCertificateID"`
+	SSLCertificateDomain string `json:"sslCertificateDomain" hash:"ignore"` // resolved to an issued ACM certificate in each region
 }
 
 type LoadBalancerHealthCheck struct {
@@ -226,6 +227,9 @@ func (c LoadBalancerClasses) Marshal(items []*simpledb.Item) {
 				case "SSLCertificateID":
 					cfg.LoadBalancerListeners[i].SSLCertificateID = val
 
+				case "SSLCertificateDomain":
+					cfg.LoadBalancerListeners[i].SSLCertificateDomain = val
+
 				}
 			}
 
@@ -0,0 +1,166 @@
+package config
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/simpledb"
+)
+
+// NetworkAclClasses is a map of Network ACL Classes
+type NetworkAclClasses map[string]NetworkAclClass
+
+// NetworkAclClass is a single Network ACL Class
+type NetworkAclClass struct {
+	NetworkAclEntries []NetworkAclEntry `json:"networkAclEntries" awsmClass:"Entries"`
+}
+
+// NetworkAclEntry is a single Network ACL Entry
+type NetworkAclEntry struct {
+	ID         string `json:"id" hash:"ignore" awsm:"ignore"`
+	Note       string `json:"note" hash:"ignore"`
+	Type       string `json:"type"` // ingress / egress
+	RuleNumber int    `json:"ruleNumber"`
+	RuleAction string `json:"ruleAction"` // allow / deny
+	Protocol   string `json:"protocol"`
+	CidrBlock  string `json:"cidrBlock"`
+	FromPort   int    `json:"fromPort"`
+	ToPort     int    `json:"toPort"`
+}
+
+// DefaultNetworkAclClasses returns the default Network ACL Classes
+func DefaultNetworkAclClasses() NetworkAclClasses {
+	defaultNetworkAcls := make(NetworkAclClasses)
+
+	defaultNetworkAcls["public"] = NetworkAclClass{
+		NetworkAclEntries: []NetworkAclEntry{
+			NetworkAclEntry{
+				Note:       "http port 80",
+				Type:       "ingress",
+				RuleNumber: 100,
+				RuleAction: "allow",
+				Protocol:   "tcp",
+				CidrBlock:  "0.0.0.0/0",
+				FromPort:   80,
+				ToPort:     80,
+			},
+			NetworkAclEntry{
+				Note:       "https port 443",
+				Type:       "ingress",
+				RuleNumber: 110,
+				RuleAction: "allow",
+				Protocol:   "tcp",
+				CidrBlock:  "0.0.0.0/0",
+				FromPort:   443,
+				ToPort:     443,
+			},
+			NetworkAclEntry{
+				Note:       "ephemeral ports",
+				Type:       "ingress",
+				RuleNumber: 120,
+				RuleAction: "allow",
+				Protocol:   "tcp",
+				CidrBlock:  "0.0.0.0/0",
+				FromPort:   1024,
+				ToPort:     65535,
+			},
+			NetworkAclEntry{
+				Note:       "all outbound",
+				Type:       "egress",
+				RuleNumber: 100,
+				RuleAction: "allow",
+				Protocol:   "all",
+				CidrBlock:  "0.0.0.0/0",
+			},
+		},
+	}
+
+	return defaultNetworkAcls
+}
+
+// SaveNetworkAclClass reads unmarshals a byte slice and inserts it into the db
+func SaveNetworkAclClass(className string, data []byte) (class NetworkAclClass, err error) {
+	err = json.Unmarshal(data, &class)
+	if err != nil {
+		return
+	}
+
+	err = Insert("networkacls", NetworkAclClasses{className: class})
+	return
+}
+
+// LoadNetworkAclClass loads a Network ACL Class by its name
+func LoadNetworkAclClass(name string) (NetworkAclClass, error) {
+	cfgs := make(NetworkAclClasses)
+	item, err := GetItemByName("networkacls", name)
+	if err != nil {
+		return cfgs[name], err
+	}
+
+	cfgs.Marshal([]*simpledb.Item{item})
+	return cfgs[name], nil
+}
+
+// LoadAllNetworkAclClasses loads all Network ACL Classes
+func LoadAllNetworkAclClasses() (NetworkAclClasses, error) {
+	cfgs := make(NetworkAclClasses)
+	items, err := GetItemsByType("networkacls")
+	if err != nil {
+		return cfgs, err
+	}
+
+	cfgs.Marshal(items)
+	return cfgs, nil
+}
+
+// Marshal puts items from SimpleDB into a Network ACL Class
+func (c NetworkAclClasses) Marshal(items []*simpledb.Item) {
+	for _, item := range items {
+		name := strings.Replace(*item.Name, "networkacls/", "", -1)
+		cfg := new(NetworkAclClass)
+
+		// Get the entries
+		entries, _ := GetItemsByType("networkacls/" + name + "/entries")
+		cfg.NetworkAclEntries = make([]NetworkAclEntry, len(entries))
+		for i, entry := range entries {
+
+			cfg.NetworkAclEntries[i].ID = strings.Replace(*entry.Name, "networkacls/"+name+"/entries/", "", -1)
+
+			for _, attribute := range entry.Attributes {
+
+				val := *attribute.Value
+
+				switch *attribute.Name {
+
+				case "Note":
+					cfg.NetworkAclEntries[i].Note = val
+
+				case "Type":
+					cfg.NetworkAclEntries[i].Type = val
+
+				case "RuleNumber":
+					cfg.NetworkAclEntries[i].RuleNumber, _ = strconv.Atoi(val)
+
+				case "RuleAction":
+					cfg.NetworkAclEntries[i].RuleAction = val
+
+				case "Protocol":
+					cfg.NetworkAclEntries[i].Protocol = val
+
+				case "CidrBlock":
+					cfg.NetworkAclEntries[i].CidrBlock = val
+
+				case "FromPort":
+					cfg.NetworkAclEntries[i].FromPort, _ = strconv.Atoi(val)
+
+				case "ToPort":
+					cfg.NetworkAclEntries[i].ToPort, _ = strconv.Atoi(val)
+
+				}
+			}
+		}
+
+		c[name] = *cfg
+	}
+}
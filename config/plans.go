@@ -0,0 +1,134 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/simpledb"
+)
+
+// PlanRecord is the record of who wrote a plan, keyed by the hash of the plan
+type PlanRecord struct {
+	Hash      string    `json:"hash"`
+	CreatedBy string    `json:"createdBy"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// PlanApprovals is a list of Plan Approvals
+type PlanApprovals []PlanApproval
+
+// PlanApproval is the record of the approval of a plan, keyed by the hash of the plan and the approver
+type PlanApproval struct {
+	Hash string    `json:"hash"`
+	By   string    `json:"by"`
+	At   time.Time `json:"at"`
+}
+
+// SavePlanRecord records the author of a plan into the db, a plan can only be recorded once
+func SavePlanRecord(record PlanRecord) error {
+	return putPlanItem("plans/"+record.Hash, BuildAttributes(record, "plans"))
+}
+
+// LoadPlanRecord loads the record of a plan by its hash
+func LoadPlanRecord(hash string) (PlanRecord, error) {
+	var record PlanRecord
+
+	item, err := GetItemByName("plans", hash)
+	if err != nil {
+		return record, errors.New("Unable to find a record of this plan, it was either not written with --plan-out or has been modified since!")
+	}
+
+	for _, attribute := range item.Attributes {
+
+		val := *attribute.Value
+
+		switch *attribute.Name {
+
+		case "Hash":
+			record.Hash = val
+
+		case "CreatedBy":
+			record.CreatedBy = val
+
+		case "CreatedAt":
+			record.CreatedAt, _ = time.Parse("2006-01-02 15:04:05.999999999 +0000 UTC", val)
+
+		}
+	}
+
+	if record.Hash != hash {
+		return record, errors.New("The record of this plan does not match its hash!")
+	}
+
+	return record, nil
+}
+
+// SavePlanApproval records the approval of a plan into the db, each approver can only approve a plan once
+func SavePlanApproval(approval PlanApproval) error {
+	return putPlanItem("planapprovals/"+approval.Hash+"/"+approval.By, BuildAttributes(approval, "planapprovals/"+approval.Hash))
+}
+
+// LoadPlanApprovals loads all of the approvals of a plan by its hash
+func LoadPlanApprovals(hash string) (PlanApprovals, error) {
+	var approvals PlanApprovals
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String("us-east-1")})) // TODO handle default region preference
+	svc := simpledb.New(sess)
+
+	params := &simpledb.SelectInput{
+		SelectExpression: aws.String(fmt.Sprintf("select * from awsm where classType = 'planapprovals/%s'", hash)),
+		ConsistentRead:   aws.Bool(true),
+	}
+
+	err := svc.SelectPages(params, func(page *simpledb.SelectOutput, lastPage bool) bool {
+		for _, item := range page.Items {
+			approval := PlanApproval{Hash: hash}
+
+			for _, attribute := range item.Attributes {
+
+				val := *attribute.Value
+
+				switch *attribute.Name {
+
+				case "By":
+					approval.By = val
+
+				case "At":
+					approval.At, _ = time.Parse("2006-01-02 15:04:05.999999999 +0000 UTC", val)
+
+				}
+			}
+
+			approvals = append(approvals, approval)
+		}
+		return true
+	})
+
+	if err != nil {
+		return approvals, err
+	}
+
+	return approvals, nil
+}
+
+// putPlanItem writes a plan item into the db, refusing to overwrite an existing item
+func putPlanItem(name string, attributes []*simpledb.ReplaceableAttribute) error {
+
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String("us-east-1")})) // TODO handle default region preference
+	svc := simpledb.New(sess)
+
+	_, err := svc.PutAttributes(&simpledb.PutAttributesInput{
+		DomainName: aws.String("awsm"),
+		ItemName:   aws.String(name),
+		Attributes: attributes,
+		Expected: &simpledb.UpdateCondition{
+			Name:   aws.String("classType"),
+			Exists: aws.Bool(false),
+		},
+	})
+
+	return err
+}
@@ -0,0 +1,71 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/simpledb"
+)
+
+// ScheduleRuns is a map of Schedule Runs, keyed by class type and class name (ie: "snapshots/code")
+type ScheduleRuns map[string]ScheduleRun
+
+// ScheduleRun is the record of the last scheduled run of a Snapshot or Image class
+type ScheduleRun struct {
+	ClassType string    `json:"classType"`
+	Class     string    `json:"class"`
+	LastRun   time.Time `json:"lastRun"`
+	Success   bool      `json:"success"`
+	Result    string    `json:"result"`
+}
+
+// SaveScheduleRun records the last run of a scheduled class into the db
+func SaveScheduleRun(run ScheduleRun) error {
+	return Insert("scheduleruns", ScheduleRuns{run.ClassType + "/" + run.Class: run})
+}
+
+// LoadAllScheduleRuns loads all Schedule Runs
+func LoadAllScheduleRuns() (ScheduleRuns, error) {
+	runs := make(ScheduleRuns)
+	items, err := GetItemsByType("scheduleruns")
+	if err != nil {
+		return runs, err
+	}
+
+	runs.Marshal(items)
+	return runs, nil
+}
+
+// Marshal puts items from SimpleDB into Schedule Runs
+func (s ScheduleRuns) Marshal(items []*simpledb.Item) {
+	for _, item := range items {
+		name := strings.Replace(*item.Name, "scheduleruns/", "", -1)
+		run := new(ScheduleRun)
+
+		for _, attribute := range item.Attributes {
+
+			val := *attribute.Value
+
+			switch *attribute.Name {
+
+			case "ClassType":
+				run.ClassType = val
+
+			case "Class":
+				run.Class = val
+
+			case "LastRun":
+				run.LastRun, _ = time.Parse("2006-01-02 15:04:05.999999999 +0000 UTC", val)
+
+			case "Success":
+				run.Success, _ = strconv.ParseBool(val)
+
+			case "Result":
+				run.Result = val
+
+			}
+		}
+		s[name] = *run
+	}
+}
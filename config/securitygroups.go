@@ -26,6 +26,8 @@ type SecurityGroupGrant struct {
 	ToPort                   int      `json:"toPort"`
 	IPProtocol               string   `json:"ipProtocol"`
 	CidrIPs                  []string `json:"cidrIPs" hash:"set"`
+	CidrIPv6s                []string `json:"cidrIPv6s" hash:"set"`
+	PrefixListIDs            []string `json:"prefixListIDs"`
 	SourceSecurityGroupNames []string `json:"sourceSecurityGroupNames"`
 }
 
@@ -86,20 +88,38 @@ func LoadSecurityGroupClass(name string, splitGrants bool) (SecurityGroupClass,
 
 		for _, grant := range cfg.SecurityGroupGrants {
 
+			sGrant := grant
+			sGrant.CidrIPs = []string{}
+			sGrant.CidrIPv6s = []string{}
+			sGrant.PrefixListIDs = []string{}
+			sGrant.SourceSecurityGroupNames = []string{}
+
 			// Grant Source: Sec Group
 			for _, secGrp := range grant.SourceSecurityGroupNames {
-				sGrant := grant
-				sGrant.CidrIPs = []string{}
-				sGrant.SourceSecurityGroupNames = []string{secGrp}
-				sGrants = append(sGrants, sGrant)
+				secGrpGrant := sGrant
+				secGrpGrant.SourceSecurityGroupNames = []string{secGrp}
+				sGrants = append(sGrants, secGrpGrant)
 			}
 
 			// Grant Source: CIDR IP
 			for _, cidrIp := range grant.CidrIPs {
-				sGrant := grant
-				sGrant.SourceSecurityGroupNames = []string{}
-				sGrant.CidrIPs = []string{cidrIp}
-				sGrants = append(sGrants, sGrant)
+				cidrIpGrant := sGrant
+				cidrIpGrant.CidrIPs = []string{cidrIp}
+				sGrants = append(sGrants, cidrIpGrant)
+			}
+
+			// Grant Source: CIDR IPv6
+			for _, cidrIpv6 := range grant.CidrIPv6s {
+				cidrIpv6Grant := sGrant
+				cidrIpv6Grant.CidrIPv6s = []string{cidrIpv6}
+				sGrants = append(sGrants, cidrIpv6Grant)
+			}
+
+			// Grant Source: Prefix List
+			for _, prefixListId := range grant.PrefixListIDs {
+				prefixListGrant := sGrant
+				prefixListGrant.PrefixListIDs = []string{prefixListId}
+				sGrants = append(sGrants, prefixListGrant)
 			}
 		}
 		cfg.SecurityGroupGrants = sGrants
@@ -169,6 +189,12 @@ func (c SecurityGroupClasses) Marshal(items []*simpledb.Item) {
 				case "CidrIPs":
 					cfg.SecurityGroupGrants[i].CidrIPs = append(cfg.SecurityGroupGrants[i].CidrIPs, val)
 
+				case "CidrIPv6s":
+					cfg.SecurityGroupGrants[i].CidrIPv6s = append(cfg.SecurityGroupGrants[i].CidrIPv6s, val)
+
+				case "PrefixListIDs":
+					cfg.SecurityGroupGrants[i].PrefixListIDs = append(cfg.SecurityGroupGrants[i].PrefixListIDs, val)
+
 				case "SourceSecurityGroupNames":
 					cfg.SecurityGroupGrants[i].SourceSecurityGroupNames = append(cfg.SecurityGroupGrants[i].SourceSecurityGroupNames, val)
 
@@ -13,15 +13,19 @@ type SnapshotClasses map[string]SnapshotClass
 
 // SnapshotClass is a single Snapshot Class
 type SnapshotClass struct {
-	Rotate              bool     `json:"rotate" awsmClass:"Rotate"`
-	Retain              int      `json:"retain" awsmClass:"Retain"`
-	Propagate           bool     `json:"propagate" awsmClass:"Propagate"`
-	PropagateRegions    []string `json:"propagateRegions" awsmClass:"Propagate Regions"`
-	Description         string   `json:"description" awsmClass:"Description"`
-	Volume              string   `json:"volume" awsmClass:"Volume"`
-	Version             int      `json:"version" awsmClass:"Version"`
-	PreSnapshotCommand  string   `json:"preSnapshotCommand"`
-	PostSnapshotCommand string   `json:"postSnapshotCommand"`
+	Rotate              bool              `json:"rotate" awsmClass:"Rotate"`
+	Retain              int               `json:"retain" awsmClass:"Retain"`
+	Propagate           bool              `json:"propagate" awsmClass:"Propagate"`
+	PropagateRegions    []string          `json:"propagateRegions" awsmClass:"Propagate Regions"`
+	Description         string            `json:"description" awsmClass:"Description"`
+	Volume              string            `json:"volume" awsmClass:"Volume"`
+	Version             int               `json:"version" awsmClass:"Version"`
+	PreSnapshotCommand  string            `json:"preSnapshotCommand"`
+	PostSnapshotCommand string            `json:"postSnapshotCommand"`
+	Schedule            string            `json:"schedule" awsmClass:"Schedule"`
+	ShareAccounts       []string          `json:"shareAccounts" awsmClass:"Share Accounts"`
+	Encrypt             bool              `json:"encrypt" awsmClass:"Encrypt"`
+	KmsKeyIds           map[string]string `json:"kmsKeyIds" awsmClass:"KMS Key IDs"`
 }
 
 // DefaultSnapshotClasses returns the default Snapshot Classes
@@ -124,6 +128,23 @@ func (c SnapshotClasses) Marshal(items []*simpledb.Item) {
 			case "PostSnapshotCommand":
 				cfg.PostSnapshotCommand = val
 
+			case "Schedule":
+				cfg.Schedule = val
+
+			case "ShareAccounts":
+				cfg.ShareAccounts = append(cfg.ShareAccounts, val)
+
+			case "Encrypt":
+				cfg.Encrypt, _ = strconv.ParseBool(val)
+
+			case "KmsKeyIds":
+				if cfg.KmsKeyIds == nil {
+					cfg.KmsKeyIds = make(map[string]string)
+				}
+				if parts := strings.SplitN(val, "=", 2); len(parts) == 2 {
+					cfg.KmsKeyIds[parts[0]] = parts[1]
+				}
+
 			}
 		}
 		c[name] = *cfg
@@ -13,7 +13,8 @@ type SubnetClasses map[string]SubnetClass
 
 // SubnetClass is a single Subnet Class
 type SubnetClass struct {
-	CIDR string `json:"cidr" awsmClass:"CIDR"`
+	CIDR       string `json:"cidr" awsmClass:"CIDR"`
+	NetworkAcl string `json:"networkAcl" awsmClass:"Network ACL"`
 
 	// INTERNET GATEWAY
 	CreateInternetGateway              bool `json:"createInternetGateway" awsmClass:"Create Internet Gateway"`
@@ -94,6 +95,9 @@ func (c SubnetClasses) Marshal(items []*simpledb.Item) {
 			case "CIDR":
 				cfg.CIDR = val
 
+			case "NetworkAcl":
+				cfg.NetworkAcl = val
+
 			case "CreateInternetGateway":
 				cfg.CreateInternetGateway, _ = strconv.ParseBool(val)
 
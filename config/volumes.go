@@ -23,6 +23,7 @@ type VolumeClass struct {
 	Encrypted           bool   `json:"encrypted" awsmClass:"Encrypted"`
 	AttachCommand       string `json:"attachCommand"`
 	DetachCommand       string `json:"detachCommand"`
+	GrowCommand         string `json:"growCommand"`
 }
 
 // DefaultVolumeClasses returns the default Volume Classes
@@ -142,6 +143,9 @@ func (c VolumeClasses) Marshal(items []*simpledb.Item) {
 			case "DetachCommand":
 				cfg.DetachCommand = val
 
+			case "GrowCommand":
+				cfg.GrowCommand = val
+
 			}
 
 			c[name] = *cfg
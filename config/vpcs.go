@@ -12,8 +12,9 @@ type VpcClasses map[string]VpcClass
 
 // VpcClass is a single Vpc Class
 type VpcClass struct {
-	CIDR    string `json:"cidr" awsmClass:"CIDR"`
-	Tenancy string `json:"tenancy" awsmClass:"Tenancy"`
+	CIDR      string   `json:"cidr" awsmClass:"CIDR"`
+	Tenancy   string   `json:"tenancy" awsmClass:"Tenancy"`
+	Endpoints []string `json:"endpoints" awsmClass:"Endpoints"` // s3, dynamodb, ec2, ssm, etc
 }
 
 // DefaultVpcClasses returns the default Vpc Classes
@@ -21,8 +22,9 @@ func DefaultVpcClasses() VpcClasses {
 	defaultVpcs := make(VpcClasses)
 
 	defaultVpcs["awsm"] = VpcClass{
-		CIDR:    "/16",
-		Tenancy: "default",
+		CIDR:      "/16",
+		Tenancy:   "default",
+		Endpoints: []string{"s3"},
 	}
 
 	return defaultVpcs
@@ -80,6 +82,9 @@ func (c VpcClasses) Marshal(items []*simpledb.Item) {
 			case "Tenancy":
 				cfg.Tenancy = val
 
+			case "Endpoints":
+				cfg.Endpoints = append(cfg.Endpoints, val)
+
 			}
 		}
 
@@ -7,6 +7,10 @@ type Alarm struct {
 	Description string   `json:"description" awsmTable:"Description"`
 	State       string   `json:"state" awsmTable:"State"`
 	Trigger     string   `json:"trigger" awsmTable:"Trigger"`
+	MetricName  string   `json:"metricName"`
+	Statistic   string   `json:"statistic"`
+	Operator    string   `json:"operator"`
+	Threshold   float64  `json:"threshold"`
 	Period      string   `json:"period" awsmTable:"Period"`
 	EvalPeriods string   `json:"evalPeriods" awsmTable:"Evaluation Periods"`
 	ActionArns  []string `json:"actionArns"`
@@ -21,6 +21,7 @@ type AutoScaleGroup struct {
 	Region                 string   `json:"region" awsmTable:"Region"`
 	LoadBalancers          []string `json:"loadBalancers" awsmTable:"Load Balancers"`
 	AvailabilityZones      []string `json:"availabilityZones" awsmTable:"Availability Zones"`
+	InstanceMix            string   `json:"instanceMix" awsmTable:"Instance Mix"`
 	//Instances         string
 }
 
@@ -0,0 +1,12 @@
+package models
+
+// BootstrapStep represents a single asset in the dependency ordered plan for bootstrapping an AutoScaling Group class
+type BootstrapStep struct {
+	Step      int      `json:"step" awsmTable:"Step"`
+	AssetType string   `json:"assetType" awsmTable:"Asset Type"`
+	Class     string   `json:"class" awsmTable:"Class"`
+	Detail    string   `json:"detail" awsmTable:"Detail"`
+	DependsOn []string `json:"dependsOn" awsmTable:"Depends On"`
+	Action    string   `json:"action" awsmTable:"Action"`
+	Region    string   `json:"region" awsmTable:"Region"`
+}
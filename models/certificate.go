@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// Certificate represents an ACM Certificate
+type Certificate struct {
+	Domain           string    `json:"domain" awsmTable:"Domain"`
+	AlternativeNames []string  `json:"alternativeNames" awsmTable:"Alternative Names"`
+	Status           string    `json:"status" awsmTable:"Status"`
+	Type             string    `json:"type" awsmTable:"Type"`
+	NotAfter         time.Time `json:"notAfter" awsmTable:"Expires"`
+	InUseBy          []string  `json:"inUseBy" awsmTable:"In Use By"`
+	Arn              string    `json:"arn"`
+	Region           string    `json:"region" awsmTable:"Region"`
+}
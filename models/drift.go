@@ -0,0 +1,12 @@
+package models
+
+// Drift represents a single difference between a class-managed asset and its class
+type Drift struct {
+	AssetType string `json:"assetType" awsmTable:"Asset Type"`
+	Name      string `json:"name" awsmTable:"Name"`
+	Class     string `json:"class" awsmTable:"Class"`
+	Field     string `json:"field" awsmTable:"Field"`
+	Expected  string `json:"expected" awsmTable:"Expected"`
+	Actual    string `json:"actual" awsmTable:"Actual"`
+	Region    string `json:"region" awsmTable:"Region"`
+}
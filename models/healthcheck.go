@@ -0,0 +1,16 @@
+package models
+
+// HealthCheck represents a Route53 Health Check
+type HealthCheck struct {
+	Name             string `json:"name" awsmTable:"Name"`
+	Id               string `json:"id" awsmTable:"Id"`
+	Type             string `json:"type" awsmTable:"Type"`
+	Target           string `json:"target" awsmTable:"Target"`
+	IPAddress        string `json:"ipAddress"`
+	FQDN             string `json:"fqdn"`
+	Port             int    `json:"port" awsmTable:"Port"`
+	ResourcePath     string `json:"resourcePath" awsmTable:"Path"`
+	RequestInterval  int    `json:"requestInterval" awsmTable:"Interval"`
+	FailureThreshold int    `json:"failureThreshold" awsmTable:"Threshold"`
+	Status           string `json:"status" awsmTable:"Status"`
+}
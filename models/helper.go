@@ -108,6 +108,12 @@ func ExtractAwsmTable(index int, in interface{}, header *[]string, rows *[][]str
 			case "[]config.SecurityGroupGrant":
 				// nothing, yet
 
+			case "[]config.NetworkAclEntry":
+				// nothing, yet
+
+			case "[]models.NetworkAclAssociation":
+				// nothing, yet
+
 			case "[]models.RouteTableAssociation":
 				var assocStr []string
 				associations := tV.Field(k).Interface().([]RouteTableAssociation)
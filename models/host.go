@@ -14,23 +14,33 @@ type ResourceRecord struct {
 	Name          string      `json:"name" awsmTable:"Name"`
 	Type          string      `json:"type" awsmTable:"Type"`
 	TTL           int         `json:"ttl" awsmTable:"TTL"`
-	HealthCheckId string      `json:"region"`
+	SetIdentifier string      `json:"setIdentifier" awsmTable:"Set Identifier"`
+	RoutingPolicy string      `json:"routingPolicy" awsmTable:"Routing Policy"`
+	Weight        int         `json:"weight"`
+	HealthCheckId string      `json:"healthCheckId" awsmTable:"Health Check"`
 	Values        []string    `json:"values"`
 	TableValues   []string    `json:"tableValues" awsmTable:"Values"`
 	AliasTarget   AliasTarget `json:"aliasTarget"`
 	Region        string      `json:"region" awsmTable:"Region"`
-	Failover      string      `json:"region" awsmTable:"Failover"`
+	Failover      string      `json:"failover" awsmTable:"Failover"`
 	HostedZoneId  string      `json:"hostedZoneId" awsmTable:"Hosted Zone Id"`
 	//GeoLocation
 }
 
 // ResourceRecordChange represents a Route53 Resource Record Change
 type ResourceRecordChange struct {
-	Action string   `json:"action" awsmTable:"Action"`
-	Name   string   `json:"name" awsmTable:"Name"`
-	Values []string `json:"values" awsmTable:"Values"`
-	Type   string   `json:"type" awsmTable:"Type"`
-	TTL    int      `json:"ttl" awsmTable:"TTL"`
+	Action        string      `json:"action" awsmTable:"Action"`
+	Name          string      `json:"name" awsmTable:"Name"`
+	Values        []string    `json:"values" awsmTable:"Values"`
+	Type          string      `json:"type" awsmTable:"Type"`
+	TTL           int         `json:"ttl" awsmTable:"TTL"`
+	SetIdentifier string      `json:"setIdentifier" awsmTable:"Set Identifier"`
+	RoutingPolicy string      `json:"routingPolicy" awsmTable:"Routing Policy"`
+	Weight        int         `json:"weight"`
+	Failover      string      `json:"failover"`
+	Region        string      `json:"region"`
+	HealthCheckId string      `json:"healthCheckId"`
+	AliasTarget   AliasTarget `json:"aliasTarget"`
 }
 
 type AliasTarget struct {
@@ -14,4 +14,5 @@ type Image struct {
 	VolumeSize   string    `json:"volumeSize" awsmTable:"Volume Size"`
 	Region       string    `json:"region" awsmTable:"Region"`
 	AmiName      string    `json:"-"`
+	RootDevice   string    `json:"rootDevice"`
 }
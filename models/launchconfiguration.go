@@ -4,14 +4,33 @@ import "time"
 
 // LaunchConfig represents an AutoScaling Launch Configuration
 type LaunchConfig struct {
-	Name           string    `json:"name" awsmTable:"Name"`
-	ImageName      string    `json:"imageName" awsmTable:"Image Name"`
-	ImageID        string    `json:"imageID" awsmTable:"Image ID"`
-	InstanceType   string    `json:"instanceType" awsmTable:"Instance Type"`
-	KeyName        string    `json:"keyName" awsmTable:"Key Name"`
-	SecurityGroups string    `json:"securityGroups" awsmTable:"Security Groups"`
-	CreationTime   time.Time `json:"creationTime" awsmTable:"Created"`
-	Region         string    `json:"region" awsmTable:"Region"`
-	EbsOptimized   bool      `json:"ebsOptimized" awsmTable:"EBS Optimized"`
-	SnapshotIDs    []string  `json:"snapshotID" awsmTable:"Snapshot IDs"`
+	Name             string    `json:"name" awsmTable:"Name"`
+	ImageName        string    `json:"imageName" awsmTable:"Image Name"`
+	ImageID          string    `json:"imageID" awsmTable:"Image ID"`
+	InstanceType     string    `json:"instanceType" awsmTable:"Instance Type"`
+	KeyName          string    `json:"keyName" awsmTable:"Key Name"`
+	SecurityGroups   string    `json:"securityGroups" awsmTable:"Security Groups"`
+	SecurityGroupIDs []string  `json:"securityGroupIDs"`
+	CreationTime     time.Time `json:"creationTime" awsmTable:"Created"`
+	Region           string    `json:"region" awsmTable:"Region"`
+	EbsOptimized     bool      `json:"ebsOptimized" awsmTable:"EBS Optimized"`
+	SnapshotIDs      []string  `json:"snapshotID" awsmTable:"Snapshot IDs"`
+
+	UserData           string                    `json:"userData"` // base64 encoded, as AWS returns it
+	IamInstanceProfile string                    `json:"iamInstanceProfile"`
+	RootDeviceName     string                    `json:"rootDeviceName"`
+	BlockDevices       []LaunchConfigBlockDevice `json:"blockDevices"`
+}
+
+// LaunchConfigBlockDevice represents a single Block Device Mapping of a Launch Configuration
+type LaunchConfigBlockDevice struct {
+	DeviceName          string `json:"deviceName"`
+	VirtualName         string `json:"virtualName"`
+	NoDevice            bool   `json:"noDevice"`
+	SnapshotID          string `json:"snapshotID"`
+	VolumeType          string `json:"volumeType"`
+	VolumeSize          int    `json:"volumeSize"`
+	Iops                int    `json:"iops"`
+	DeleteOnTermination bool   `json:"deleteOnTermination"`
+	Encrypted           bool   `json:"encrypted"`
 }
@@ -14,6 +14,7 @@ type LoadBalancer struct {
 	AvailabilityZones       []string                       `json:"availabilityZone" awsmTable:"Availability Zones"`
 	CreatedTime             time.Time                      `json:"createdTime" awsmTable:"Created"`
 	SecurityGroups          []string                       `json:"securityGroups" awsmTable:"Security Groups"`
+	SecurityGroupIDs        []string                       `json:"securityGroupIDs"`
 	Scheme                  string                         `json:"scheme" awsmTable:"Scheme"`
 	Vpc                     string                         `json:"vpc" awsmTable:"VPC"`
 	VpcID                   string                         `json:"vpcID"`
@@ -21,6 +22,7 @@ type LoadBalancer struct {
 	SubnetClasses           []string                       `json:"subnetsClasses"`
 	SubnetIDs               []string                       `json:"subnetIDs"`
 	DNSName                 string                         `json:"dnsName"`
+	CanonicalHostedZoneID   string                         `json:"canonicalHostedZoneID"`
 	LoadBalancerListeners   []config.LoadBalancerListener  `json:"loadBalancerListeners"`
 	LoadBalancerHealthCheck config.LoadBalancerHealthCheck `json:"loadBalancerHealthCheck"`
 	LoadBalancerAttributes  config.LoadBalancerAttributes  `json:"loadBalancerAttributes"`
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// LogGroup represents a CloudWatch Logs Log Group
+type LogGroup struct {
+	Name          string    `json:"name" awsmTable:"Name"`
+	RetentionDays int       `json:"retentionDays" awsmTable:"Retention Days"`
+	StoredBytes   string    `json:"storedBytes" awsmTable:"Stored"`
+	MetricFilters int       `json:"metricFilters" awsmTable:"Metric Filters"`
+	CreationTime  time.Time `json:"creationTime" awsmTable:"Created"`
+	Arn           string    `json:"arn"`
+	Region        string    `json:"region" awsmTable:"Region"`
+}
+
+// LogEvent represents a single CloudWatch Logs event
+type LogEvent struct {
+	Timestamp time.Time `json:"timestamp" awsmTable:"Time"`
+	LogGroup  string    `json:"logGroup" awsmTable:"Log Group"`
+	LogStream string    `json:"logStream" awsmTable:"Log Stream"`
+	Message   string    `json:"message" awsmTable:"Message"`
+	EventID   string    `json:"eventID"`
+	Region    string    `json:"region" awsmTable:"Region"`
+}
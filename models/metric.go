@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// MetricSeries represents the CloudWatch statistics of a single metric for a single asset
+type MetricSeries struct {
+	AssetType  string            `json:"assetType"`
+	AssetName  string            `json:"assetName"`
+	AssetID    string            `json:"assetID"`
+	Namespace  string            `json:"namespace"`
+	Metric     string            `json:"metric"`
+	Statistic  string            `json:"statistic"`
+	Unit       string            `json:"unit"`
+	Period     int               `json:"period"`
+	Region     string            `json:"region"`
+	Datapoints []MetricDatapoint `json:"datapoints"`
+}
+
+// MetricDatapoint represents a single CloudWatch statistic value
+type MetricDatapoint struct {
+	Timestamp time.Time `json:"timestamp"`
+	Value     float64   `json:"value"`
+}
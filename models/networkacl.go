@@ -0,0 +1,23 @@
+package models
+
+import "github.com/murdinc/awsm/config"
+
+// NetworkAcl represents a VPC Network ACL
+type NetworkAcl struct {
+	Name              string                   `json:"name" awsmTable:"Name"`
+	Class             string                   `json:"class" awsmTable:"Class"`
+	NetworkAclID      string                   `json:"networkAclID" awsmTable:"Network ACL ID"`
+	VpcName           string                   `json:"vpcName" awsmTable:"VPC Name"`
+	VpcID             string                   `json:"vpcID" awsmTable:"VPC ID"`
+	Default           bool                     `json:"default" awsmTable:"Default"`
+	Subnets           []string                 `json:"subnets" awsmTable:"Subnets"`
+	Associations      []NetworkAclAssociation  `json:"associations"`
+	NetworkAclEntries []config.NetworkAclEntry `json:"networkAclEntries"`
+	Region            string                   `json:"region" awsmTable:"Region"`
+}
+
+// NetworkAclAssociation represents a single Network ACL Association
+type NetworkAclAssociation struct {
+	AssociationID string `json:"associationID"`
+	SubnetID      string `json:"subnetID"`
+}
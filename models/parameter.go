@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// Parameter represents an SSM Parameter Store parameter, values are never included
+type Parameter struct {
+	Name             string    `json:"name" awsmTable:"Name"`
+	Type             string    `json:"type" awsmTable:"Type"`
+	Description      string    `json:"description" awsmTable:"Description"`
+	KeyID            string    `json:"keyID" awsmTable:"KMS Key"`
+	Version          int       `json:"version" awsmTable:"Version"`
+	LastModifiedDate time.Time `json:"lastModifiedDate" awsmTable:"Last Modified"`
+	LastModifiedUser string    `json:"lastModifiedUser" awsmTable:"Modified By"`
+	Region           string    `json:"region" awsmTable:"Region"`
+}
@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// Schedule represents a scheduled Snapshot or Image class
+type Schedule struct {
+	ClassType string    `json:"classType" awsmTable:"Class Type"`
+	Class     string    `json:"class" awsmTable:"Class"`
+	Schedule  string    `json:"schedule" awsmTable:"Schedule"`
+	NextRun   time.Time `json:"nextRun" awsmTable:"Next Run"`
+	LastRun   time.Time `json:"lastRun" awsmTable:"Last Run"`
+	Running   bool      `json:"running" awsmTable:"Running"`
+	Success   bool      `json:"success" awsmTable:"Success"`
+	Result    string    `json:"result" awsmTable:"Result"`
+}
@@ -7,6 +7,7 @@ type SecurityGroup struct {
 	Name                string                      `json:"name" awsmTable:"Name"`
 	Class               string                      `json:"class" awsmTable:"Class"`
 	GroupID             string                      `json:"groupID" awsmTable:"Group ID"`
+	GroupName           string                      `json:"groupName"`
 	Description         string                      `json:"description" awsmTable:"Description"`
 	Vpc                 string                      `json:"vpc" awsmTable:"VPC"`
 	VpcID               string                      `json:"vpcID" awsmTable:"VPC ID"`
@@ -13,5 +13,6 @@ type Snapshot struct {
 	StartTime   time.Time `json:"startTime" awsmTable:"Created"`
 	Progress    string    `json:"progress" awsmTable:"Progress"`
 	VolumeSize  string    `json:"volumeSize" awsmTable:"Volume Size"`
+	Encrypted   bool      `json:"encrypted" awsmTable:"Encrypted"`
 	Region      string    `json:"region" awsmTable:"Region"`
 }
@@ -0,0 +1,12 @@
+package models
+
+// TeardownStep represents a single asset in the reverse dependency ordered plan for tearing down a VPC
+type TeardownStep struct {
+	Step      int    `json:"step" awsmTable:"Step"`
+	AssetType string `json:"assetType" awsmTable:"Asset Type"`
+	ID        string `json:"id" awsmTable:"ID"`
+	Name      string `json:"name" awsmTable:"Name"`
+	Action    string `json:"action" awsmTable:"Action"`
+	VpcID     string `json:"vpcID" awsmTable:"VPC ID"`
+	Region    string `json:"region" awsmTable:"Region"`
+}
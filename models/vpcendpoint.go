@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// VpcEndpoint represents a VPC Endpoint
+type VpcEndpoint struct {
+	VpcEndpointID   string    `json:"vpcEndpointID" awsmTable:"VPC Endpoint ID"`
+	VpcEndpointType string    `json:"vpcEndpointType" awsmTable:"Type"`
+	ServiceName     string    `json:"serviceName" awsmTable:"Service Name"`
+	State           string    `json:"state" awsmTable:"State"`
+	VpcName         string    `json:"vpcName" awsmTable:"VPC Name"`
+	VpcID           string    `json:"vpcID" awsmTable:"VPC ID"`
+	RouteTables     []string  `json:"routeTables" awsmTable:"Route Tables"`
+	Subnets         []string  `json:"subnets" awsmTable:"Subnets"`
+	PrivateDNS      bool      `json:"privateDNS" awsmTable:"Private DNS"`
+	CreationTime    time.Time `json:"creationTime" awsmTable:"Created"`
+	Region          string    `json:"region" awsmTable:"Region"`
+}